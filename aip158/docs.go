@@ -0,0 +1,5 @@
+// Package aip158 implements the Google AIP-158 pagination, https://google.aip.dev/158,
+// with the `page_size`, `page_token` and `next_page_token` fields, on top of page-based and cursor-based paginations.
+// The page tokens contain a fingerprint of the other request parameters, e.g. `filter` and `order_by`,
+// so a token can't be used with a different request.
+package aip158
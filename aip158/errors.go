@@ -0,0 +1,33 @@
+package aip158
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrPageSizeCantBeNegative is returned when the page size is negative.
+	ErrPageSizeCantBeNegative = errors.New("page size can't be negative")
+	// ErrPageTokenNotValid is returned when the page token can't be decoded.
+	ErrPageTokenNotValid = errors.New("page token is not valid")
+	// ErrPaginationNotSupported is returned when the pagination type can't be paginated with page tokens.
+	ErrPaginationNotSupported       = errors.New("pagination is not supported")
+	_                         error = new(RequestChangedError)
+)
+
+// RequestChangedError is returned when the request parameters changed since the page token was created.
+type RequestChangedError struct {
+	// Params are the names of the parameters that changed.
+	Params []string
+}
+
+// Error returns the error message.
+func (e RequestChangedError) Error() string {
+	return fmt.Sprintf("request parameters changed since the page token was created: [%s]", strings.Join(e.Params, ", "))
+}
+
+// Unwrap returns ErrPageTokenNotValid, since the page token is not valid for the request.
+func (e RequestChangedError) Unwrap() error {
+	return ErrPageTokenNotValid
+}
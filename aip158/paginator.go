@@ -0,0 +1,152 @@
+package aip158
+
+import (
+	"cmp"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+// DefaultPageSize is the page size when the request page size is zero.
+const DefaultPageSize = 50
+
+type (
+	// Request is the pagination part of an AIP-158 list request.
+	Request struct {
+		// PageSize is the `page_size` field, the default page size if zero.
+		PageSize int32
+		// PageToken is the `page_token` field, the first page if empty.
+		PageToken string
+		// Params are the other request parameters that can't change between pages, e.g. `filter` and `order_by`.
+		// Empty values are the same as missing parameters.
+		Params map[string]string
+	}
+
+	// Paginator converts AIP-158 requests to paginations and creates the next page tokens.
+	// The zero value uses DefaultPageSize and no maximum page size.
+	Paginator struct {
+		// DefaultPageSize is the page size when the request page size is zero, DefaultPageSize if zero.
+		DefaultPageSize int
+		// MaxPageSize is the maximum page size, bigger page sizes are coerced to it as AIP-158 recommends.
+		// No maximum if zero.
+		MaxPageSize int
+	}
+)
+
+// Page converts the request to a page pagination with the sort.
+// The page token contains the exact offset of the page, so if the page size changes between pages,
+// the page starts at that offset, and no rows are repeated or skipped.
+//
+// Errors:
+//   - ErrPageSizeCantBeNegative if the page size is negative.
+//   - ErrPageTokenNotValid if the page token can't be decoded.
+//   - RequestChangedError if the request parameters changed since the page token was created.
+func (p Paginator) Page(request Request, orders ...pagegeneric.Order) (*pagepagination.Pagination, error) {
+	size, err := p.pageSize(request)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := 0
+
+	if request.PageToken != "" {
+		token, errDecoding := decodePageToken(request.PageToken, request.Params)
+		if errDecoding != nil {
+			return nil, errDecoding
+		}
+
+		if token.Cursor != "" {
+			return nil, ErrPageTokenNotValid
+		}
+
+		offset = token.Offset
+	}
+
+	return pagepagination.NewOffset(offset, size, orders...)
+}
+
+// Cursor converts the request to a cursor pagination with the cursors definition.
+//
+// Errors:
+//   - ErrPageSizeCantBeNegative if the page size is negative.
+//   - ErrPageTokenNotValid if the page token can't be decoded.
+//   - RequestChangedError if the request parameters changed since the page token was created.
+//   - Any error returned by cursorpagination.FromToken.
+func (p Paginator) Cursor(request Request, cursors ...cursorpagination.Cursor) (*cursorpagination.Pagination, error) {
+	size, err := p.pageSize(request)
+	if err != nil {
+		return nil, err
+	}
+
+	cursorToken := ""
+
+	if request.PageToken != "" {
+		token, errDecoding := decodePageToken(request.PageToken, request.Params)
+		if errDecoding != nil {
+			return nil, errDecoding
+		}
+
+		if token.Cursor == "" {
+			return nil, ErrPageTokenNotValid
+		}
+
+		cursorToken = token.Cursor
+	}
+
+	return cursorpagination.FromToken(size, cursorToken, cursors...)
+}
+
+// NextPageToken returns the `next_page_token` of a pagination that has run with the request,
+// or an empty token if there is no next page.
+//
+// Errors:
+//   - ErrPaginationNotSupported if the pagination is not a page or cursor pagination.
+//   - Any error encoding the page token.
+func (p Paginator) NextPageToken(request Request, pagination pagorminator.Pagination) (string, error) {
+	token := pageToken{Offset: 0, Cursor: "", Fingerprint: fingerprint(request.Params)}
+
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination:
+		next, ok := typed.Next()
+		if !ok {
+			return "", nil
+		}
+
+		token.Offset = next.Offset()
+	case *cursorpagination.Pagination:
+		next, ok := typed.Next()
+		if !ok {
+			return "", nil
+		}
+
+		cursorToken, err := next.Token()
+		if err != nil {
+			return "", err
+		}
+
+		token.Cursor = cursorToken
+	default:
+		return "", ErrPaginationNotSupported
+	}
+
+	return token.encode()
+}
+
+func (p Paginator) pageSize(request Request) (int, error) {
+	size := int(request.PageSize)
+
+	switch {
+	case size < 0:
+		return 0, ErrPageSizeCantBeNegative
+	case size == 0:
+		size = cmp.Or(p.DefaultPageSize, DefaultPageSize)
+	}
+
+	if p.MaxPageSize > 0 {
+		size = min(size, p.MaxPageSize)
+	}
+
+	return size, nil
+}
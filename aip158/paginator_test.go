@@ -0,0 +1,211 @@
+package aip158
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestPaginatorPage(t *testing.T) {
+	t.Parallel()
+
+	params := map[string]string{"filter": `price > 10`, "order_by": "price desc"}
+	paginator := Paginator{DefaultPageSize: 0, MaxPageSize: 100}
+
+	firstPage, err := paginator.Page(Request{PageSize: 10, PageToken: "", Params: params}, pagegeneric.Desc("price"))
+	if err != nil {
+		t.Fatalf("Page() unexpected error: %v", err)
+	}
+
+	_ = firstPage.SetTotalElements(25)
+
+	nextPageToken, err := paginator.NextPageToken(Request{PageSize: 10, PageToken: "", Params: params}, firstPage)
+	if err != nil {
+		t.Fatalf("NextPageToken() unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		request    Request
+		wantOffset int
+		wantSize   int
+		wantErr    error
+		// wantChanged are the params of the expected RequestChangedError.
+		wantChanged []string
+	}{
+		"first page with default size": {
+			request:    Request{PageSize: 0, PageToken: "", Params: nil},
+			wantOffset: 0,
+			wantSize:   DefaultPageSize,
+		},
+		"page size coerced to max": {
+			request:    Request{PageSize: 1000, PageToken: "", Params: nil},
+			wantOffset: 0,
+			wantSize:   100,
+		},
+		"next page": {
+			request:    Request{PageSize: 10, PageToken: nextPageToken, Params: params},
+			wantOffset: 10,
+			wantSize:   10,
+		},
+		"next page with empty params order": {
+			request: Request{
+				PageSize:  10,
+				PageToken: nextPageToken,
+				Params:    map[string]string{"order_by": "price desc", "filter": `price > 10`, "show_deleted": ""},
+			},
+			wantOffset: 10,
+			wantSize:   10,
+		},
+		"next page with smaller page size": {
+			request:    Request{PageSize: 5, PageToken: nextPageToken, Params: params},
+			wantOffset: 10,
+			wantSize:   5,
+		},
+		"next page with bigger page size": {
+			request:    Request{PageSize: 15, PageToken: nextPageToken, Params: params},
+			wantOffset: 10,
+			wantSize:   15,
+		},
+		"negative page size": {
+			request: Request{PageSize: -1, PageToken: "", Params: nil},
+			wantErr: ErrPageSizeCantBeNegative,
+		},
+		"page token not valid": {
+			request: Request{PageSize: 10, PageToken: "not a token", Params: params},
+			wantErr: ErrPageTokenNotValid,
+		},
+		"filter changed": {
+			request: Request{
+				PageSize:  10,
+				PageToken: nextPageToken,
+				Params:    map[string]string{"filter": `price > 20`, "order_by": "price desc"},
+			},
+			wantChanged: []string{"filter"},
+		},
+		"params added and removed": {
+			request: Request{
+				PageSize:  10,
+				PageToken: nextPageToken,
+				Params:    map[string]string{"filter": `price > 10`, "show_deleted": "true"},
+			},
+			wantChanged: []string{"order_by", "show_deleted"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := paginator.Page(test.request, pagegeneric.Desc("price"))
+			if test.wantChanged != nil {
+				var gotChangedErr RequestChangedError
+				if !errors.As(gotErr, &gotChangedErr) || !errors.Is(gotErr, ErrPageTokenNotValid) {
+					t.Fatalf("Page() error = %v, want RequestChangedError", gotErr)
+				}
+
+				if diff := cmp.Diff(test.wantChanged, gotChangedErr.Params); diff != "" {
+					t.Errorf("Page() changed params diff (-want +got):\n%s", diff)
+				}
+
+				return
+			}
+
+			if test.wantErr != nil {
+				if !errors.Is(gotErr, test.wantErr) {
+					t.Errorf("Page() error = %v, want %v", gotErr, test.wantErr)
+				}
+
+				return
+			}
+
+			if gotErr != nil {
+				t.Fatalf("Page() unexpected error: %v", gotErr)
+			}
+
+			if got.Offset() != test.wantOffset || got.Size() != test.wantSize {
+				t.Errorf("Page() = (offset %d, size %d), want (offset %d, size %d)",
+					got.Offset(), got.Size(), test.wantOffset, test.wantSize)
+			}
+		})
+	}
+}
+
+func TestPaginatorCursor(t *testing.T) {
+	t.Parallel()
+
+	params := map[string]string{"filter": `code = "A"`}
+	paginator := Paginator{}
+
+	firstPage, err := paginator.Cursor(Request{PageSize: 2, PageToken: "", Params: params}, cursorpagination.Asc("id", nil))
+	if err != nil {
+		t.Fatalf("Cursor() unexpected error: %v", err)
+	}
+
+	if firstPage.HasCursorValues() {
+		t.Errorf("Cursor() first page has cursor values")
+	}
+
+	firstPage.SetLatestQueryValues(2, map[string]any{"id": 4})
+
+	nextPageToken, err := paginator.NextPageToken(Request{PageSize: 2, PageToken: "", Params: params}, firstPage)
+	if err != nil {
+		t.Fatalf("NextPageToken() unexpected error: %v", err)
+	}
+
+	nextPage, err := paginator.Cursor(Request{PageSize: 2, PageToken: nextPageToken, Params: params}, cursorpagination.Asc("id", nil))
+	if err != nil {
+		t.Fatalf("Cursor() unexpected error: %v", err)
+	}
+
+	if got := nextPage.Cursors()[0].Value(); got != int64(4) {
+		t.Errorf("Cursor() next page cursor value = %v, want 4", got)
+	}
+
+	nextPage.SetLatestQueryValues(1, map[string]any{"id": 5})
+
+	lastPageToken, err := paginator.NextPageToken(Request{PageSize: 2, PageToken: nextPageToken, Params: params}, nextPage)
+	if err != nil || lastPageToken != "" {
+		t.Errorf("NextPageToken() = (%q, %v), want empty token", lastPageToken, err)
+	}
+
+	_, err = paginator.Cursor(Request{PageSize: 2, PageToken: nextPageToken, Params: nil}, cursorpagination.Asc("id", nil))
+	if !errors.As(err, new(RequestChangedError)) {
+		t.Errorf("Cursor() error = %v, want RequestChangedError", err)
+	}
+
+	_, err = paginator.Page(Request{PageSize: 2, PageToken: nextPageToken, Params: params})
+	if !errors.Is(err, ErrPageTokenNotValid) {
+		t.Errorf("Page() with cursor token error = %v, want %v", err, ErrPageTokenNotValid)
+	}
+}
+
+func TestPaginatorNextPageToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("last page", func(t *testing.T) {
+		t.Parallel()
+
+		pagination := pagepagination.Must(2, 10)
+		_ = pagination.SetTotalElements(25)
+
+		got, err := Paginator{}.NextPageToken(Request{}, pagination)
+		if err != nil || got != "" {
+			t.Errorf("NextPageToken() = (%q, %v), want empty token", got, err)
+		}
+	})
+
+	t.Run("pagination not supported", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Paginator{}.NextPageToken(Request{}, idpagination.Must(0, 10, "id", 1, 2))
+		if !errors.Is(err, ErrPaginationNotSupported) {
+			t.Errorf("NextPageToken() error = %v, want %v", err, ErrPaginationNotSupported)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package aip158
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// pageToken is the content of the opaque page token.
+type pageToken struct {
+	// Offset is the offset of the next page, for page pagination.
+	Offset int `json:"o,omitempty"`
+	// Cursor is the cursor token of the next page, for cursor pagination.
+	Cursor string `json:"c,omitempty"`
+	// Fingerprint is the hash of each of the request parameters.
+	Fingerprint map[string]string `json:"f,omitempty"`
+}
+
+func (t pageToken) encode() (string, error) {
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("encoding page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodePageToken decodes the page token and checks it was created for the same request parameters.
+//
+// Errors:
+//   - ErrPageTokenNotValid if the page token can't be decoded.
+//   - RequestChangedError if the request parameters changed.
+func decodePageToken(token string, params map[string]string) (pageToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageToken{}, fmt.Errorf("%w: %w", ErrPageTokenNotValid, err)
+	}
+
+	var decoded pageToken
+	if err = json.Unmarshal(raw, &decoded); err != nil {
+		return pageToken{}, fmt.Errorf("%w: %w", ErrPageTokenNotValid, err)
+	}
+
+	if decoded.Offset < 0 {
+		return pageToken{}, fmt.Errorf("%w: negative offset", ErrPageTokenNotValid)
+	}
+
+	if changed := changedParams(decoded.Fingerprint, fingerprint(params)); len(changed) > 0 {
+		return pageToken{}, RequestChangedError{Params: changed}
+	}
+
+	return decoded, nil
+}
+
+// fingerprint hashes each of the non-empty request parameters.
+func fingerprint(params map[string]string) map[string]string {
+	hashes := make(map[string]string, len(params))
+	for name, value := range params {
+		if value == "" {
+			continue
+		}
+
+		sum := sha256.Sum256([]byte(value))
+		hashes[name] = hex.EncodeToString(sum[:8])
+	}
+
+	return hashes
+}
+
+// changedParams returns the sorted names of the parameters whose hash is different.
+func changedParams(before, after map[string]string) []string {
+	var changed []string
+
+	for name, hash := range before {
+		if after[name] != hash {
+			changed = append(changed, name)
+		}
+	}
+
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+
+	slices.Sort(changed)
+
+	return changed
+}
@@ -0,0 +1,170 @@
+package pagorminator
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+// pluginPkgPath is the package path of the plugin, to skip its frames when looking for the source location.
+var pluginPkgPath = reflect.TypeFor[PaGorminator]().PkgPath()
+
+// checkConflicts checks that the paginated query has no clauses conflicting with the pagination.
+func (p PaGorminator) checkConflicts(db *gorm.DB) {
+	// the raw statements are checked by the raw callback.
+	if isRawStatement(db.Statement) {
+		return
+	}
+
+	pagination, ok := p.getPageRequest(db)
+	if !ok {
+		return
+	}
+
+	p.reportConflicts(db, pagination)
+}
+
+// reportConflicts reports the clauses conflicting with the pagination.
+// In strict mode, the conflict is added to the query error, otherwise a warning is logged with the source location.
+func (p PaGorminator) reportConflicts(db *gorm.DB, pagination Pagination) {
+	err := findConflict(db.Statement, pagination)
+	if err == nil {
+		return
+	}
+
+	if p.Strict {
+		_ = db.AddError(err)
+
+		return
+	}
+
+	source := sourceLocation()
+	if p.Logger != nil {
+		p.Logger.WarnContext(db.Statement.Context, "pagorminator: pagination conflict",
+			"table", db.Statement.Table, "error", err, "source", source)
+
+		return
+	}
+
+	db.Logger.Warn(db.Statement.Context, "pagorminator: %v, at %s", err, source)
+}
+
+// findConflict returns the first clause conflicting with the pagination.
+//
+// Errors:
+//   - ErrMultiplePaginations if more than one pagination clause was applied.
+//   - ErrLimitConflict if the limit or offset are not the ones of the pagination, or were applied before it.
+//   - ErrOrderConflict if the order by doesn't start with the cursor order.
+func findConflict(stmt *gorm.Statement, pagination Pagination) error {
+	if value, ok := stmt.Settings.Load(pagegeneric.PagorminatorClauses); ok {
+		return fmt.Errorf("%w: %v pagination clauses", ErrMultiplePaginations, value)
+	}
+
+	wantLimit, checkLimit := paginationLimit(pagination)
+
+	if value, ok := stmt.Settings.Load(pagegeneric.PagorminatorLimit); ok && checkLimit {
+		if limit, isLimit := value.(clause.Limit); isLimit && (limit.Limit != nil || limit.Offset != 0) {
+			return fmt.Errorf("%w: limit %s offset %d applied before the pagination",
+				ErrLimitConflict, formatLimit(limit.Limit), limit.Offset)
+		}
+	}
+
+	limit, _ := stmt.Clauses["LIMIT"].Expression.(clause.Limit)
+
+	if checkLimit && (!equalLimits(limit.Limit, wantLimit.Limit) || limit.Offset != wantLimit.Offset) {
+		return fmt.Errorf("%w: limit %s offset %d, the pagination applies limit %s offset %d",
+			ErrLimitConflict, formatLimit(limit.Limit), limit.Offset, formatLimit(wantLimit.Limit), wantLimit.Offset)
+	}
+
+	if cursorPagination, ok := pagination.(*cursorpagination.Pagination); ok {
+		return findOrderConflict(stmt, cursorPagination.Sort())
+	}
+
+	return nil
+}
+
+// paginationLimit returns the limit applied by the pagination, and whether it can be checked.
+func paginationLimit(pagination Pagination) (clause.Limit, bool) {
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination:
+		if typed.IsUnPaged() {
+			return clause.Limit{}, true
+		}
+
+		size := typed.Size()
+
+		return clause.Limit{Limit: &size, Offset: typed.Offset()}, true
+	case *cursorpagination.Pagination:
+		if typed.Size() == 0 {
+			return clause.Limit{}, true
+		}
+
+		size := typed.Size()
+
+		return clause.Limit{Limit: &size}, true
+	default:
+		return clause.Limit{}, false
+	}
+}
+
+// findOrderConflict returns ErrOrderConflict if the order by doesn't start with the cursor sort.
+// The cursor sorts with vars are added by the plugin after any other order by.
+func findOrderConflict(stmt *gorm.Statement, sort pagegeneric.Sort) error {
+	orderByClause, hasOrderBy := stmt.Clauses["ORDER BY"]
+	if !hasOrderBy || len(sort) == 0 {
+		return nil
+	}
+
+	orderBy, _ := orderByClause.Expression.(clause.OrderBy)
+	if !sort.HasVars() && orderBy.Expression == nil && len(orderBy.Columns) > 0 &&
+		orderBy.Columns[0].Column.Name == sort.String() {
+		return nil
+	}
+
+	return fmt.Errorf("%w: the order by doesn't start with the cursor order %q", ErrOrderConflict, sort.String())
+}
+
+func equalLimits(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func formatLimit(limit *int) string {
+	if limit == nil {
+		return "none"
+	}
+
+	return fmt.Sprint(*limit)
+}
+
+// sourceLocation returns the file and line of the first caller outside gorm and the plugin.
+func sourceLocation() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+
+		inGorm := strings.HasPrefix(frame.Function, "gorm.io/")
+		inPlugin := strings.HasPrefix(frame.Function, pluginPkgPath) && !strings.HasSuffix(frame.File, "_test.go")
+
+		if !inGorm && !inPlugin && frame.File != "" {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
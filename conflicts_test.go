@@ -0,0 +1,177 @@
+package pagorminator
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestConflictsStrict(t *testing.T) {
+	t.Parallel()
+
+	db := setupDBWithPlugin(t, PaGorminator{Strict: true})
+	migrateTestStructs(t, db, 5)
+
+	products := []*TestProduct{
+		{Code: "1", Price: TestPrice{Amount: 1, Currency: "EUR"}},
+		{Code: "2", Price: TestPrice{Amount: 2, Currency: "EUR"}},
+	}
+	if err := db.Create(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		query   func(db *gorm.DB) *gorm.DB
+		dest    any
+		wantErr error
+	}{
+		"page pagination": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(pagepagination.Must(1, 2))
+			},
+		},
+		"page pagination with the same clause twice": {
+			query: func(db *gorm.DB) *gorm.DB {
+				pageRequest := pagepagination.Must(1, 2)
+
+				return db.Clauses(pageRequest).Clauses(pageRequest)
+			},
+		},
+		"page pagination with preload": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(pagepagination.Must(0, 2)).Preload("Price")
+			},
+			dest: &[]*TestProduct{},
+		},
+		"page pagination with limit": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(pagepagination.Must(0, 2)).Limit(50)
+			},
+			wantErr: ErrLimitConflict,
+		},
+		"page pagination with offset": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(pagepagination.Must(1, 2)).Offset(10)
+			},
+			wantErr: ErrLimitConflict,
+		},
+		"limit before page pagination": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Limit(50).Clauses(pagepagination.Must(0, 2))
+			},
+			wantErr: ErrLimitConflict,
+		},
+		"offset before cursor pagination": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Offset(2).Clauses(cursorpagination.Must(2, cursorpagination.Asc("price", nil)))
+			},
+			wantErr: ErrLimitConflict,
+		},
+		"unpaged with limit": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Limit(2).Clauses(pagepagination.UnPaged())
+			},
+			wantErr: ErrLimitConflict,
+		},
+		"two pagination clauses": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(pagepagination.Must(0, 2)).Clauses(cursorpagination.Must(2, cursorpagination.Asc("id", nil)))
+			},
+			wantErr: ErrMultiplePaginations,
+		},
+		"cursor pagination with order after": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(cursorpagination.Must(2, cursorpagination.Asc("price", nil))).Order("code")
+			},
+		},
+		"cursor pagination with order before": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Order("code DESC").Clauses(cursorpagination.Must(2, cursorpagination.Asc("price", nil)))
+			},
+			wantErr: ErrOrderConflict,
+		},
+		"cursor pagination with offset": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(cursorpagination.Must(2, cursorpagination.Asc("price", 2))).Offset(2)
+			},
+			wantErr: ErrLimitConflict,
+		},
+		"id pagination with limit": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(idpagination.Must(0, 2, "id", 3, 1)).Limit(1)
+			},
+		},
+		"raw query with limit": {
+			query: func(db *gorm.DB) *gorm.DB {
+				return db.Clauses(pagepagination.Must(0, 2)).Limit(1).Raw("SELECT * FROM test_structs")
+			},
+			wantErr: ErrLimitConflict,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dest := test.dest
+			if dest == nil {
+				dest = &[]*TestStruct{}
+			}
+
+			tx := test.query(db).Find(dest)
+			if diff := cmp.Diff(test.wantErr, tx.Error, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("error mismatch (-want +got):\n%s", diff)
+			}
+
+			if test.wantErr != nil && strings.Count(tx.Error.Error(), test.wantErr.Error()) != 1 {
+				t.Errorf("expected the error reported once, got %q", tx.Error)
+			}
+		})
+	}
+}
+
+func TestConflictsWarning(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	db := setupDBWithPlugin(t, PaGorminator{Logger: logger})
+	migrateTestStructs(t, db, 5)
+
+	var products []*TestStruct
+	if err := db.Clauses(pagepagination.Must(0, 2)).Limit(3).Find(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if len(products) != 3 {
+		t.Errorf("expected the manual limit to be applied, got %d products", len(products))
+	}
+
+	var record map[string]any
+	if err := json.Unmarshal(buffer.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff("WARN", record["level"]); diff != "" {
+		t.Errorf("level mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff("pagorminator: pagination conflict", record["msg"]); diff != "" {
+		t.Errorf("msg mismatch (-want +got):\n%s", diff)
+	}
+
+	if source, _ := record["source"].(string); !strings.Contains(source, "conflicts_test.go:") {
+		t.Errorf("expected the source location in the test file, got %q", source)
+	}
+}
@@ -0,0 +1,51 @@
+package pagorminator
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// CountModeKey is the statement setting to control the count query of a paginated query,
+// e.g. db.Set(pagorminator.CountModeKey, pagorminator.CountSkip).
+const CountModeKey = "pagorminator:count"
+
+const (
+	// CountAuto counts the total elements if they are not set yet in the pagination.
+	CountAuto CountMode = iota
+	// CountSkip does not count the total elements, so they are not set if they were not set before.
+	CountSkip
+	// CountForce counts the total elements even if they are already set in the pagination.
+	// The id pagination total elements are the number of ids, so they are not counted.
+	CountForce
+)
+
+type (
+	// CountMode controls the count query of a paginated query.
+	CountMode int
+
+	countModeKey struct{}
+)
+
+// WithCountMode returns a context whose paginated queries use the count mode.
+// The CountModeKey statement setting takes precedence over the context.
+func WithCountMode(ctx context.Context, mode CountMode) context.Context {
+	return context.WithValue(ctx, countModeKey{}, mode)
+}
+
+// countModeOf returns the count mode of the statement, from its settings or its context.
+func countModeOf(db *gorm.DB) CountMode {
+	if modeRaw, ok := db.Get(CountModeKey); ok {
+		if mode, isMode := modeRaw.(CountMode); isMode {
+			return mode
+		}
+	}
+
+	if db.Statement.Context != nil {
+		if mode, ok := db.Statement.Context.Value(countModeKey{}).(CountMode); ok {
+			return mode
+		}
+	}
+
+	return CountAuto
+}
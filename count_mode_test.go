@@ -0,0 +1,130 @@
+package pagorminator
+
+import (
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestCountMode(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	migrateTestStructs(t, db, 5)
+
+	tests := map[string]struct {
+		pagination func() Pagination
+		tx         func(*gorm.DB) *gorm.DB
+		wantTotal  int64
+		wantSet    bool
+	}{
+		"page pagination, auto": {
+			pagination: func() Pagination { return pagepagination.Must(0, 2) },
+			tx:         func(db *gorm.DB) *gorm.DB { return db },
+			wantTotal:  5,
+			wantSet:    true,
+		},
+		"page pagination, skip": {
+			pagination: func() Pagination { return pagepagination.Must(0, 2) },
+			tx:         func(db *gorm.DB) *gorm.DB { return db.Set(CountModeKey, CountSkip) },
+			wantSet:    false,
+		},
+		"page pagination, total set": {
+			pagination: func() Pagination {
+				p := pagepagination.Must(0, 2)
+				_ = p.SetTotalElements(100)
+
+				return p
+			},
+			tx:        func(db *gorm.DB) *gorm.DB { return db },
+			wantTotal: 100,
+			wantSet:   true,
+		},
+		"page pagination, total set, force": {
+			pagination: func() Pagination {
+				p := pagepagination.Must(0, 2)
+				_ = p.SetTotalElements(100)
+
+				return p
+			},
+			tx:        func(db *gorm.DB) *gorm.DB { return db.Set(CountModeKey, CountForce) },
+			wantTotal: 5,
+			wantSet:   true,
+		},
+		"cursor pagination, skip with context": {
+			pagination: func() Pagination { return cursorpagination.Must(2, cursorpagination.Asc("id", 1)) },
+			tx: func(db *gorm.DB) *gorm.DB {
+				return db.WithContext(WithCountMode(t.Context(), CountSkip))
+			},
+			wantSet: false,
+		},
+		"cursor pagination, total set, force with context": {
+			pagination: func() Pagination {
+				p := cursorpagination.Must(2, cursorpagination.Asc("id", 1))
+				_ = p.SetTotalElements(100)
+
+				return p
+			},
+			tx: func(db *gorm.DB) *gorm.DB {
+				return db.WithContext(WithCountMode(t.Context(), CountForce))
+			},
+			wantTotal: 5,
+			wantSet:   true,
+		},
+		"id pagination, force": {
+			pagination: func() Pagination { return idpagination.Must(0, 2, "id", 1, 2, 3) },
+			tx:         func(db *gorm.DB) *gorm.DB { return db.Set(CountModeKey, CountForce) },
+			wantTotal:  3,
+			wantSet:    true,
+		},
+		"setting takes precedence over context": {
+			pagination: func() Pagination { return pagepagination.Must(0, 2) },
+			tx: func(db *gorm.DB) *gorm.DB {
+				return db.WithContext(WithCountMode(t.Context(), CountSkip)).Set(CountModeKey, CountAuto)
+			},
+			wantTotal: 5,
+			wantSet:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pagination := test.pagination()
+
+			var products []*TestStruct
+			if err := test.tx(db).Clauses(pagination).Find(&products).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			if len(products) != 2 {
+				t.Errorf("expected 2 products, got %d", len(products))
+			}
+
+			if gotTotal, gotSet := pagination.TotalElements(); gotTotal != test.wantTotal || gotSet != test.wantSet {
+				t.Errorf("TotalElements() = (%d, %t), want (%d, %t)", gotTotal, gotSet, test.wantTotal, test.wantSet)
+			}
+		})
+	}
+}
+
+func TestFindCountSkip(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	migrateTestStructs(t, db, 5)
+
+	got, err := Find[TestStruct](t.Context(), db.Set(CountModeKey, CountSkip), pagepagination.Must(0, 2))
+	if err != nil {
+		t.Fatalf("Find() unexpected error: %v", err)
+	}
+
+	if len(got.Content) != 2 || got.TotalElements != 0 {
+		t.Errorf("Find() = %+v, want 2 rows without total", got)
+	}
+}
@@ -12,7 +12,9 @@ var (
 	// ErrCursorsRequired is returned when the order is required.
 	ErrCursorsRequired = errors.New("order is required")
 	// ErrOrderNotValid is returned when the order is not valid.
-	ErrOrderNotValid       = errors.New("order is not valid")
+	ErrOrderNotValid = errors.New("order is not valid")
+	// ErrTokenNotValid is returned when the cursor token can't be decoded.
+	ErrTokenNotValid       = errors.New("cursor token is not valid")
 	_                error = new(CursorValuesNotValidError)
 )
 
@@ -31,6 +31,8 @@ type (
 		latestLen int
 		// latestCursorValues represents the cursor latest values of the latest query using this pagination.
 		latestCursorValues map[string]any
+		// latestErr represents the error capturing the cursor values of the latest query using this pagination.
+		latestErr error
 	}
 )
 
@@ -40,7 +42,7 @@ type (
 // Errors:
 //   - ErrSizeCantBeNegative if the size value is below zero.
 //   - ErrCursorsRequired if the cursors are empty.
-//   - ErrOrderNotValid if the order is not Asc or Desc
+//   - ErrOrderNotValid if the order is not Asc, Desc, Random or ValueList
 //   - CursorValuesNotValidError if some cursors have values and some others do not.
 func New(size int, cursors ...Cursor) (*Pagination, error) {
 	if size < 0 {
@@ -57,7 +59,7 @@ func New(size int, cursors ...Cursor) (*Pagination, error) {
 
 	for _, cursor := range cursors {
 		switch cursor.order.(type) {
-		case pagegeneric.Asc, pagegeneric.Desc:
+		case pagegeneric.Asc, pagegeneric.Desc, pagegeneric.Random, pagegeneric.ValueList:
 			column := cursor.Column()
 			if cursor.value == nil {
 				hasNilValue = append(hasNilValue, column)
@@ -108,6 +110,16 @@ func (p *Pagination) Cursors() []Cursor {
 	return slices.Clone(p.cursors)
 }
 
+// Sort Get the sort constraints defined by the cursors.
+func (p *Pagination) Sort() pagegeneric.Sort {
+	sort := make(pagegeneric.Sort, len(p.cursors))
+	for i, cursor := range p.cursors {
+		sort[i] = cursor.order
+	}
+
+	return sort
+}
+
 // TotalElements returns the total elements.
 func (p *Pagination) TotalElements() (int64, bool) {
 	p.mu.RLock()
@@ -148,6 +160,11 @@ func (p *Pagination) IsTotalElementsSet() bool {
 	return p.totalElementsSet
 }
 
+// HasCursorValues Check whether the cursors have values, i.e. it is not the first page.
+func (p *Pagination) HasCursorValues() bool {
+	return len(p.cursors) > 0 && p.cursors[0].value != nil
+}
+
 // SetLatestQueryValues sets the latest query values.
 // Method to be used by the plugin callbacks.
 func (p *Pagination) SetLatestQueryValues(latestLen int, latestCursorValues map[string]any) {
@@ -157,6 +174,28 @@ func (p *Pagination) SetLatestQueryValues(latestLen int, latestCursorValues map[
 	p.latestCursorValuesSet = true
 	p.latestLen = latestLen
 	p.latestCursorValues = latestCursorValues
+	p.latestErr = nil
+}
+
+// SetLatestQueryError sets the error capturing the cursor values of the latest query, e.g. a cursor column
+// that is not a field of the model, so the next page can't be retrieved.
+// Method to be used by the plugin callbacks.
+func (p *Pagination) SetLatestQueryError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.latestCursorValuesSet = false
+	p.latestLen = 0
+	p.latestCursorValues = nil
+	p.latestErr = err
+}
+
+// Err returns the error capturing the cursor values of the latest query, or nil.
+func (p *Pagination) Err() error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.latestErr
 }
 
 // Next Get the next cursor pagination request.
@@ -197,6 +236,18 @@ func Desc(column string, value any) Cursor {
 	return newCursor(pagegeneric.Desc(column), value)
 }
 
+// Random creates a pseudo-random cursor for an integer column, usually the primary key, shuffled with the seed.
+// The cursor value is the column value of the latest row, not its hash.
+func Random(column string, seed uint64, value any) Cursor {
+	return newCursor(pagegeneric.NewRandom(column, seed), value)
+}
+
+// ValueList creates a cursor for a column sorted by the position of its value in the values list.
+// The cursor value is the column value of the latest row, not its position.
+func ValueList(column string, values []any, value any) Cursor {
+	return newCursor(pagegeneric.NewValueList(column, values...), value)
+}
+
 func newCursor(order pagegeneric.Order, value any) Cursor {
 	return Cursor{order: order, value: value}
 }
@@ -211,6 +262,11 @@ func (c Cursor) Value() any {
 	return c.value
 }
 
+// WithValue returns a copy of the cursor with the given value.
+func (c Cursor) WithValue(value any) Cursor {
+	return Cursor{order: c.order, value: value}
+}
+
 // Order returns the order definition of a cursor.
 func (c Cursor) Order() pagegeneric.Order {
 	return c.order
@@ -12,17 +12,18 @@ import (
 // ModifyStatement Modify the query clause to apply cursor pagination.
 func (p *Pagination) ModifyStatement(stm *gorm.Statement) {
 	tx := stm.DB
-	tx.Set(pagegeneric.PagorminatorClause, p)
+	pagegeneric.SetClause(tx, p)
 
-	if p.hasCursorValues() {
+	if p.HasCursorValues() {
 		cursorWhereSQL, cursorVars := p.buildCursorWhere()
-		tx.Set(pagegeneric.PagorminatorCursorWhereSQL, cursorWhereSQL)
-		tx.Set(pagegeneric.PagorminatorCursorWhereVars, cursorVars)
-		tx = tx.Where(cursorWhereSQL, cursorVars...)
+		tx = tx.Where(Condition{SQL: cursorWhereSQL, Vars: cursorVars})
 	}
 
-	if len(p.cursors) > 0 {
-		tx = tx.Order(p.sortString())
+	if sort := p.Sort(); sort.HasVars() {
+		// the order by vars are bound by the plugin.
+		tx.Set(pagegeneric.PagorminatorSort, sort)
+	} else if len(sort) > 0 {
+		tx = tx.Order(sort.String())
 	}
 
 	if p.size > 0 {
@@ -30,6 +31,29 @@ func (p *Pagination) ModifyStatement(stm *gorm.Statement) {
 	}
 }
 
+// Condition is the where condition of the rows after the cursor values.
+// It is a dedicated clause expression, so the plugin removes it from the count query,
+// and other plugins can recognize it, e.g. to exclude it from the caching keys.
+type Condition struct {
+	SQL  string
+	Vars []any
+}
+
+// Build builds the condition, in parentheses when it combines several cursors,
+// so it is not mixed with the other conditions.
+func (c Condition) Build(builder clause.Builder) {
+	expression := clause.Expr{SQL: c.SQL, Vars: c.Vars}
+	if !strings.Contains(c.SQL, " OR ") {
+		expression.Build(builder)
+
+		return
+	}
+
+	builder.WriteByte('(')
+	expression.Build(builder)
+	builder.WriteByte(')')
+}
+
 // Build N/A for pagination.
 func (p *Pagination) Build(_ clause.Builder) {
 	// method needed to implement interface [clause.Expression]
@@ -53,42 +77,46 @@ func (p *Pagination) buildCursorWhere() (string, []any) {
 				whereSQL.WriteString(" AND ")
 			}
 
-			whereSQL.WriteString(p.cursors[j].Column())
-			whereSQL.WriteString(" = ?")
-
-			vars = append(vars, p.cursors[j].value)
+			vars = writeComparison(&whereSQL, vars, p.cursors[j], " = ")
 		}
 
 		if i > 0 {
 			whereSQL.WriteString(" AND ")
 		}
 
-		whereSQL.WriteString(p.cursors[i].Column())
-
 		switch p.cursors[i].order.(type) {
-		case pagegeneric.Asc:
-			whereSQL.WriteString(" > ?")
 		case pagegeneric.Desc:
-			whereSQL.WriteString(" < ?")
+			vars = writeComparison(&whereSQL, vars, p.cursors[i], " < ")
+		default:
+			vars = writeComparison(&whereSQL, vars, p.cursors[i], " > ")
 		}
 
-		vars = append(vars, p.cursors[i].value)
-
 		whereSQL.WriteString(")")
 	}
 
 	return whereSQL.String(), vars
 }
 
-func (p *Pagination) hasCursorValues() bool {
-	return len(p.cursors) > 0 && p.cursors[0].value != nil
-}
+// writeComparison writes the comparison between the cursor column and its value.
+// For expression orders, the expression is computed on both sides.
+func writeComparison(whereSQL *strings.Builder, vars []any, cursor Cursor, operator string) []any {
+	expressionOrder, ok := cursor.order.(pagegeneric.ExpressionOrder)
+	if !ok {
+		whereSQL.WriteString(cursor.Column())
+		whereSQL.WriteString(operator)
+		whereSQL.WriteString("?")
 
-func (p *Pagination) sortString() string {
-	orderStrings := make([]string, len(p.cursors))
-	for i, cursor := range p.cursors {
-		orderStrings[i] = cursor.order.GormString()
+		return append(vars, cursor.value)
 	}
 
-	return strings.Join(orderStrings, ", ")
+	columnSQL, columnVars := expressionOrder.Expression(cursor.Column())
+	valueSQL, valueVars := expressionOrder.Expression("?", cursor.value)
+
+	whereSQL.WriteString(columnSQL)
+	whereSQL.WriteString(operator)
+	whereSQL.WriteString(valueSQL)
+
+	vars = append(vars, columnVars...)
+
+	return append(vars, valueVars...)
 }
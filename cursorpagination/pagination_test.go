@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"testing"
 
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/utils/tests"
+
 	"github.com/manuelarte/pagorminator/pagegeneric"
 )
 
@@ -196,6 +200,18 @@ func TestBuildCursorWhere(t *testing.T) {
 			wantSQL:  "(code > ?) OR (code = ? AND price < ?)",
 			wantVars: []any{"A", "A", 10},
 		},
+		"random with tie breaker": {
+			cursors: []Cursor{Random("id", 0, 3), Asc("code", "A")},
+			wantSQL: "(((id % 2147483659) * 1351658138 + 1646307374) % 2147483659 > ((? % 2147483659) * 1351658138 + 1646307374) % 2147483659) OR " +
+				"(((id % 2147483659) * 1351658138 + 1646307374) % 2147483659 = ((? % 2147483659) * 1351658138 + 1646307374) % 2147483659 AND code > ?)",
+			wantVars: []any{3, 3, "A"},
+		},
+		"value list with tie breaker": {
+			cursors: []Cursor{ValueList("priority", []any{"high", "low"}, "low"), Asc("id", 2)},
+			wantSQL: "(CASE priority WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END > CASE ? WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END) OR " +
+				"(CASE priority WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END = CASE ? WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END AND id > ?)",
+			wantVars: []any{"high", "low", "low", "high", "low", "high", "low", "low", "high", "low", 2},
+		},
 	}
 
 	for name, test := range tests {
@@ -269,3 +285,71 @@ func TestNext(t *testing.T) {
 		})
 	}
 }
+
+func TestSetLatestQueryError(t *testing.T) {
+	t.Parallel()
+
+	errLatest := errors.New("cursor column not found")
+	page := Must(10, Asc("id", nil))
+	page.SetLatestQueryValues(10, map[string]any{"id": 10})
+
+	page.SetLatestQueryError(errLatest)
+
+	if !errors.Is(page.Err(), errLatest) {
+		t.Errorf("Err() = %v, want %v", page.Err(), errLatest)
+	}
+
+	if _, hasNext := page.Next(); hasNext != pagegeneric.PreviousCursorValuesNotSet {
+		t.Errorf("Next() = _, %v, want %v", hasNext, pagegeneric.PreviousCursorValuesNotSet)
+	}
+
+	page.SetLatestQueryValues(10, map[string]any{"id": 10})
+
+	if page.Err() != nil {
+		t.Errorf("Err() = %v, want nil", page.Err())
+	}
+}
+
+func TestConditionBuild(t *testing.T) {
+	t.Parallel()
+
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		cursors  []Cursor
+		wantSQL  string
+		wantVars []any
+	}{
+		"single cursor": {
+			cursors:  []Cursor{Asc("id", 1)},
+			wantSQL:  "(id > ?)",
+			wantVars: []any{1},
+		},
+		"multiple cursors": {
+			cursors:  []Cursor{Asc("code", "A"), Desc("price", 10)},
+			wantSQL:  "((code > ?) OR (code = ? AND price < ?))",
+			wantVars: []any{"A", "A", 10},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			whereSQL, vars := Must(5, test.cursors...).buildCursorWhere()
+			stmt := &gorm.Statement{DB: db, Clauses: map[string]clause.Clause{}}
+			Condition{SQL: whereSQL, Vars: vars}.Build(stmt)
+
+			if stmt.SQL.String() != test.wantSQL {
+				t.Errorf("sql expected %q, got %q", test.wantSQL, stmt.SQL.String())
+			}
+
+			if fmt.Sprint(stmt.Vars) != fmt.Sprint(test.wantVars) {
+				t.Errorf("vars expected %v, got %v", test.wantVars, stmt.Vars)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package cursorpagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const tokenTimeType = "time"
+
+// tokenValue is the cursor value encoded in a token, tagged with its type when JSON can't round-trip it.
+type tokenValue struct {
+	Type  string          `json:"t,omitempty"`
+	Value json.RawMessage `json:"v"`
+}
+
+// Token returns an opaque token with the cursor values, to be used with FromToken.
+// The token only contains the values, so the cursors columns and orders can't be altered by clients.
+// It returns an empty token if the cursors have no values.
+func (p *Pagination) Token() (string, error) {
+	if !p.HasCursorValues() {
+		return "", nil
+	}
+
+	values := make(map[string]tokenValue, len(p.cursors))
+	for _, cursor := range p.cursors {
+		value, err := encodeTokenValue(cursor.value)
+		if err != nil {
+			return "", fmt.Errorf("encoding cursor %q value: %w", cursor.Column(), err)
+		}
+
+		values[cursor.Column()] = value
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("encoding token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// FromToken Create a cursor page given size, the token and the cursors definition.
+// The cursors values are taken from the token, an empty token being the first page.
+//
+// Errors:
+//   - ErrTokenNotValid if the token can't be decoded or does not match the cursors.
+//   - Any error returned by New.
+func FromToken(size int, token string, cursors ...Cursor) (*Pagination, error) {
+	if token == "" {
+		firstPage := make([]Cursor, len(cursors))
+		for i, cursor := range cursors {
+			firstPage[i] = cursor.WithValue(nil)
+		}
+
+		return New(size, firstPage...)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTokenNotValid, err)
+	}
+
+	var values map[string]tokenValue
+	if err = json.Unmarshal(raw, &values); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTokenNotValid, err)
+	}
+
+	if len(values) != len(cursors) {
+		return nil, fmt.Errorf("%w: expected %d cursor values, got %d", ErrTokenNotValid, len(cursors), len(values))
+	}
+
+	withValues := make([]Cursor, len(cursors))
+	for i, cursor := range cursors {
+		encoded, ok := values[cursor.Column()]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing cursor %q value", ErrTokenNotValid, cursor.Column())
+		}
+
+		value, errDecoding := decodeTokenValue(encoded)
+		if errDecoding != nil {
+			return nil, fmt.Errorf("%w: cursor %q value: %w", ErrTokenNotValid, cursor.Column(), errDecoding)
+		}
+
+		withValues[i] = cursor.WithValue(value)
+	}
+
+	return New(size, withValues...)
+}
+
+func encodeTokenValue(value any) (tokenValue, error) {
+	var encoded tokenValue
+
+	switch typed := value.(type) {
+	case time.Time:
+		encoded.Type = tokenTimeType
+		value = typed.Format(time.RFC3339Nano)
+	case *time.Time:
+		if typed != nil {
+			encoded.Type = tokenTimeType
+			value = typed.Format(time.RFC3339Nano)
+		}
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return tokenValue{}, err
+	}
+
+	encoded.Value = raw
+
+	return encoded, nil
+}
+
+func decodeTokenValue(encoded tokenValue) (any, error) {
+	if encoded.Type == tokenTimeType {
+		var value string
+		if err := json.Unmarshal(encoded.Value, &value); err != nil {
+			return nil, err
+		}
+
+		return time.Parse(time.RFC3339Nano, value)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded.Value))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return value, nil
+	}
+
+	if integer, err := number.Int64(); err == nil {
+		return integer, nil
+	}
+
+	return number.Float64()
+}
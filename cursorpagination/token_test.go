@@ -0,0 +1,117 @@
+package cursorpagination
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTokenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	tests := map[string]struct {
+		cursors    []Cursor
+		definition []Cursor
+		want       []any
+	}{
+		"integer value": {
+			cursors:    []Cursor{Asc("id", 3)},
+			definition: []Cursor{Asc("id", nil)},
+			want:       []any{int64(3)},
+		},
+		"string and float values": {
+			cursors:    []Cursor{Asc("code", "A"), Desc("price", 1.5)},
+			definition: []Cursor{Asc("code", nil), Desc("price", nil)},
+			want:       []any{"A", 1.5},
+		},
+		"time value": {
+			cursors:    []Cursor{Desc("created_at", createdAt), Asc("id", 2)},
+			definition: []Cursor{Desc("created_at", nil), Asc("id", nil)},
+			want:       []any{createdAt, int64(2)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := Must(10, test.cursors...).Token()
+			if err != nil {
+				t.Fatalf("Token() unexpected error: %v", err)
+			}
+
+			got, err := FromToken(10, token, test.definition...)
+			if err != nil {
+				t.Fatalf("FromToken(%q) unexpected error: %v", token, err)
+			}
+
+			gotValues := make([]any, len(got.Cursors()))
+			for i, cursor := range got.Cursors() {
+				gotValues[i] = cursor.Value()
+			}
+
+			if diff := cmp.Diff(test.want, gotValues); diff != "" {
+				t.Errorf("FromToken() values diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFromToken(t *testing.T) {
+	t.Parallel()
+
+	validToken, _ := Must(10, Asc("id", 3)).Token()
+
+	tests := map[string]struct {
+		token      string
+		definition []Cursor
+		wantErr    error
+	}{
+		"empty token is the first page": {
+			definition: []Cursor{Asc("id", 5)},
+		},
+		"not base64": {
+			token:      "not a token!",
+			definition: []Cursor{Asc("id", nil)},
+			wantErr:    ErrTokenNotValid,
+		},
+		"not json": {
+			token:      "bm90IGpzb24",
+			definition: []Cursor{Asc("id", nil)},
+			wantErr:    ErrTokenNotValid,
+		},
+		"different cursors": {
+			token:      validToken,
+			definition: []Cursor{Asc("code", nil)},
+			wantErr:    ErrTokenNotValid,
+		},
+		"valid token": {
+			token:      validToken,
+			definition: []Cursor{Asc("id", nil)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := FromToken(10, test.token, test.definition...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("FromToken(%q) = %v, want %v", test.token, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenWithoutValues(t *testing.T) {
+	t.Parallel()
+
+	token, err := Must(10, Asc("id", nil)).Token()
+	if err != nil || token != "" {
+		t.Errorf("Token() = %q, %v, want empty token", token, err)
+	}
+}
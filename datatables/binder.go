@@ -0,0 +1,135 @@
+package datatables
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/manuelarte/pagorminator/httpbind"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+// DefaultLength is the page length when the length parameter is not present, as in DataTables.
+const DefaultLength = 10
+
+type (
+	// Binder binds the DataTables server-side processing parameters.
+	// The zero value has no maximum length.
+	Binder struct {
+		// MaxLength is the maximum length allowed, no maximum if zero.
+		// If set, length -1 to get all the records is not allowed.
+		MaxLength int
+	}
+
+	// Request is the bound DataTables request.
+	Request struct {
+		// Draw is the draw counter, returned as is in the response.
+		Draw int
+		// Search is the global search value, `search[value]`, to filter the records.
+		Search string
+		// Pagination is the page pagination with the order.
+		Pagination *pagepagination.Pagination
+	}
+)
+
+// Bind binds the parameters to a page pagination.
+// The `columns[i][data]` of the order columns are the model Go field names or columns, validated against its schema.
+//
+// Errors:
+//   - httpbind.BindError with the errors of each parameter.
+//   - Any error parsing the model schema.
+func (b Binder) Bind(db *gorm.DB, model any, values url.Values) (Request, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return Request{}, fmt.Errorf("parsing model schema: %w", err)
+	}
+
+	var bindError httpbind.BindError
+
+	draw := httpbind.BindInt(&bindError, values, "draw", 0)
+	start := httpbind.BindInt(&bindError, values, "start", 0)
+	length := httpbind.BindInt(&bindError, values, "length", DefaultLength)
+
+	switch {
+	case length == -1 && b.MaxLength > 0:
+		bindError.AddParam(values, "length", httpbind.ErrSizeTooBig)
+	case length == -1:
+		// all the records, no pagination.
+		length = 0
+	case length < 1:
+		bindError.AddParam(values, "length", ErrLengthNotValid)
+	case b.MaxLength > 0 && length > b.MaxLength:
+		bindError.AddParam(values, "length", httpbind.ErrSizeTooBig)
+	}
+
+	if start < 0 {
+		bindError.AddParam(values, "start", ErrStartCantBeNegative)
+	}
+
+	sort := bindOrder(&bindError, values, stmt.Schema)
+
+	if len(bindError.Errors) > 0 {
+		return Request{}, bindError
+	}
+
+	pagination, err := pagepagination.NewOffset(start, length, sort...)
+	if err != nil {
+		bindError.AddParam(values, "start", err)
+
+		return Request{}, bindError
+	}
+
+	return Request{Draw: draw, Search: values.Get("search[value]"), Pagination: pagination}, nil
+}
+
+func bindOrder(bindError *httpbind.BindError, values url.Values, modelSchema *schema.Schema) pagegeneric.Sort {
+	var sort pagegeneric.Sort
+
+	for i := 0; ; i++ {
+		columnParam := fmt.Sprintf("order[%d][column]", i)
+		if !values.Has(columnParam) {
+			return sort
+		}
+
+		index, err := strconv.Atoi(values.Get(columnParam))
+		if err != nil {
+			bindError.AddParam(values, columnParam, httpbind.ErrNotANumber)
+
+			continue
+		}
+
+		dataParam := fmt.Sprintf("columns[%d][data]", index)
+		if !values.Has(dataParam) {
+			bindError.AddParam(values, columnParam, ErrColumnNotFound)
+
+			continue
+		}
+
+		if values.Get(fmt.Sprintf("columns[%d][orderable]", index)) == "false" {
+			bindError.AddParam(values, columnParam, ErrColumnNotOrderable)
+
+			continue
+		}
+
+		field := modelSchema.LookUpField(values.Get(dataParam))
+		if field == nil || field.DBName == "" {
+			bindError.AddParam(values, dataParam, ErrColumnNotFound)
+
+			continue
+		}
+
+		dirParam := fmt.Sprintf("order[%d][dir]", i)
+		switch values.Get(dirParam) {
+		case "", "asc":
+			sort = append(sort, pagegeneric.AscField(field.Name))
+		case "desc":
+			sort = append(sort, pagegeneric.DescField(field.Name))
+		default:
+			bindError.AddParam(values, dirParam, ErrDirNotValid)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package datatables
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/httpbind"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+type testProduct struct {
+	ID    uint `gorm:"primarykey"`
+	Name  string
+	Price uint
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	values := url.Values{
+		"draw":                  {"3"},
+		"start":                 {"2"},
+		"length":                {"2"},
+		"columns[0][data]":      {"Name"},
+		"columns[1][data]":      {"price"},
+		"columns[2][data]":      {"actions"},
+		"columns[2][orderable]": {"false"},
+		"order[0][column]":      {"1"},
+		"order[0][dir]":         {"desc"},
+		"order[1][column]":      {"0"},
+		"order[1][dir]":         {"asc"},
+		"search[value]":         {"a"},
+	}
+
+	request, err := Binder{}.Bind(db, &testProduct{}, values)
+	if err != nil {
+		t.Fatalf("Bind() unexpected error: %v", err)
+	}
+
+	got, err := Find[*testProduct](t.Context(), db, request, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("name LIKE ?", "%"+request.Search+"%")
+	})
+	if err != nil {
+		t.Fatalf("Find() unexpected error: %v", err)
+	}
+
+	want := Response[*testProduct]{
+		Draw:            3,
+		RecordsTotal:    6,
+		RecordsFiltered: 4,
+		Data: []*testProduct{
+			{ID: 4, Name: "pear", Price: 5},
+			{ID: 5, Name: "mango", Price: 3},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Find() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestFindAllRecords(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+
+	request, err := Binder{}.Bind(db, &testProduct{}, url.Values{"draw": {"1"}, "length": {"-1"}})
+	if err != nil {
+		t.Fatalf("Bind() unexpected error: %v", err)
+	}
+
+	got, err := Find[testProduct](t.Context(), db, request)
+	if err != nil {
+		t.Fatalf("Find() unexpected error: %v", err)
+	}
+
+	if got.RecordsTotal != 6 || got.RecordsFiltered != 6 || len(got.Data) != 6 {
+		t.Errorf("Find() = %+v, want all the records", got)
+	}
+}
+
+func TestFindStartNotMultipleOfLength(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	values := url.Values{"draw": {"1"}, "start": {"1"}, "length": {"2"}, "columns[0][data]": {"ID"}, "order[0][column]": {"0"}}
+
+	request, err := Binder{}.Bind(db, &testProduct{}, values)
+	if err != nil {
+		t.Fatalf("Bind() unexpected error: %v", err)
+	}
+
+	got, err := Find[testProduct](t.Context(), db, request)
+	if err != nil {
+		t.Fatalf("Find() unexpected error: %v", err)
+	}
+
+	if len(got.Data) != 2 || got.Data[0].ID != 2 || got.Data[1].ID != 3 {
+		t.Errorf("Find() data = %+v, want the records 2 and 3", got.Data)
+	}
+}
+
+func TestBinderBindErrors(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+
+	tests := map[string]struct {
+		binder   Binder
+		values   url.Values
+		wantErrs map[string][]error
+	}{
+		"start and length not valid": {
+			values: url.Values{"draw": {"one"}, "start": {"5"}, "length": {"0"}},
+			wantErrs: map[string][]error{
+				"draw":   {httpbind.ErrNotANumber},
+				"length": {ErrLengthNotValid},
+			},
+		},
+		"start with all the records": {
+			values: url.Values{"start": {"5"}, "length": {"-1"}},
+			wantErrs: map[string][]error{
+				"start": {pagepagination.ErrSizeNotAllowed},
+			},
+		},
+		"max length": {
+			binder: Binder{MaxLength: 100},
+			values: url.Values{"start": {"-10"}, "length": {"-1"}},
+			wantErrs: map[string][]error{
+				"length": {httpbind.ErrSizeTooBig},
+				"start":  {ErrStartCantBeNegative},
+			},
+		},
+		"order not valid": {
+			values: url.Values{
+				"columns[0][data]":      {"Name"},
+				"columns[1][data]":      {"missing"},
+				"columns[2][data]":      {"price"},
+				"columns[2][orderable]": {"false"},
+				"order[0][column]":      {"0"},
+				"order[0][dir]":         {"up"},
+				"order[1][column]":      {"1"},
+				"order[2][column]":      {"2"},
+				"order[3][column]":      {"7"},
+			},
+			wantErrs: map[string][]error{
+				"order[0][dir]":    {ErrDirNotValid},
+				"columns[1][data]": {ErrColumnNotFound},
+				"order[2][column]": {ErrColumnNotOrderable},
+				"order[3][column]": {ErrColumnNotFound},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.binder.Bind(db, &testProduct{}, test.values)
+
+			var bindError httpbind.BindError
+			if !errors.As(err, &bindError) {
+				t.Fatalf("Bind() error = %v, want BindError", err)
+			}
+
+			if diff := cmp.Diff(test.wantErrs, bindError.ByParam(), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Bind() errors diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func setupDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
+	if err != nil {
+		t.Fatal("failed to connect database")
+	}
+
+	if err = db.AutoMigrate(&testProduct{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Use(pagorminator.PaGorminator{}); err != nil {
+		t.Fatal(err)
+	}
+
+	products := []*testProduct{
+		{Name: "apple", Price: 5},
+		{Name: "banana", Price: 8},
+		{Name: "cherry", Price: 8},
+		{Name: "pear", Price: 5},
+		{Name: "mango", Price: 3},
+		{Name: "kiwi", Price: 1},
+	}
+	if err = db.Create(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
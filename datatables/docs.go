@@ -0,0 +1,5 @@
+// Package datatables implements the DataTables server-side processing protocol,
+// https://datatables.net/manual/server-side, binding the `draw`, `start`, `length`, `order[i][column]`,
+// `order[i][dir]` and `columns[i][data]` parameters to page-based pagination,
+// and creating the response with the `draw`, `recordsTotal`, `recordsFiltered` and `data` fields.
+package datatables
@@ -0,0 +1,16 @@
+package datatables
+
+import "errors"
+
+var (
+	// ErrStartCantBeNegative is returned when start is negative.
+	ErrStartCantBeNegative = errors.New("start can't be negative")
+	// ErrLengthNotValid is returned when length is not positive nor -1, to get all the records.
+	ErrLengthNotValid = errors.New("length must be positive or -1")
+	// ErrColumnNotFound is returned when an order column index is not in the columns, or its data is not in the model.
+	ErrColumnNotFound = errors.New("column not found")
+	// ErrColumnNotOrderable is returned when ordering by a column that is not orderable.
+	ErrColumnNotOrderable = errors.New("column is not orderable")
+	// ErrDirNotValid is returned when the order direction is not asc or desc.
+	ErrDirNotValid = errors.New("order direction must be asc or desc")
+)
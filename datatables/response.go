@@ -0,0 +1,54 @@
+package datatables
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator"
+)
+
+// Response is the DataTables server-side processing response.
+//
+//go:structinit
+type Response[T any] struct {
+	// Draw is the draw counter of the request.
+	Draw int `json:"draw"`
+	// RecordsTotal is the total number of records, before filtering.
+	RecordsTotal int64 `json:"recordsTotal"`
+	// RecordsFiltered is the total number of records after filtering.
+	RecordsFiltered int64 `json:"recordsFiltered"`
+	// Data are the records of the page.
+	Data []T `json:"data"`
+}
+
+// Find runs the query with the filter scopes and returns the response.
+// The records filtered are counted by the plugin, and the records total are counted from the same model without the filters.
+//
+// Errors:
+//   - Any error counting the records total.
+//   - Any error returned by pagorminator.Find.
+func Find[T any](
+	ctx context.Context,
+	db *gorm.DB,
+	request Request,
+	filters ...func(*gorm.DB) *gorm.DB,
+) (Response[T], error) {
+	var recordsTotal int64
+	if err := db.WithContext(ctx).Model(new(T)).Count(&recordsTotal).Error; err != nil {
+		return Response[T]{}, fmt.Errorf("counting records total: %w", err)
+	}
+
+	page, err := pagorminator.Find[T](ctx, db.WithContext(ctx).Scopes(filters...), request.Pagination)
+	if err != nil {
+		return Response[T]{}, err
+	}
+
+	return Response[T]{
+		Draw:            request.Draw,
+		RecordsTotal:    recordsTotal,
+		RecordsFiltered: page.TotalElements,
+		Data:            page.Content,
+	}, nil
+}
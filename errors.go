@@ -0,0 +1,62 @@
+package pagorminator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrPluginNotRegistered is returned when the PaGorminator plugin is not registered in the gorm DB.
+	ErrPluginNotRegistered = errors.New("pagorminator plugin is not registered")
+	// ErrPaginationRequired is returned when the pagination is nil.
+	ErrPaginationRequired = errors.New("pagination is required")
+	// ErrTotalElementsNotSet is returned when the total elements are not set after the query,
+	// e.g. when the query has no model or table.
+	ErrTotalElementsNotSet = errors.New("total elements are not set after the query")
+	// ErrPaginationNotIterable is returned when the pagination type can't retrieve the next page.
+	ErrPaginationNotIterable = errors.New("pagination is not iterable")
+	// ErrCallbackPrefixNotValid is returned when the callback prefix has leading or trailing spaces.
+	ErrCallbackPrefixNotValid = errors.New("callback prefix is not valid")
+	// ErrCallbackPositionNotValid is returned when a callback position is not valid.
+	ErrCallbackPositionNotValid = errors.New("callback position is not valid")
+	// ErrCountDBNotValid is returned when the count DB dialect is not the one of the paginated DB.
+	ErrCountDBNotValid = errors.New("count db is not valid")
+	// ErrMultiplePaginations is returned in strict mode when more than one pagination clause is applied.
+	ErrMultiplePaginations = errors.New("multiple pagination clauses")
+	// ErrLimitConflict is returned in strict mode when the limit or offset conflict with the pagination.
+	ErrLimitConflict = errors.New("limit or offset conflict with the pagination")
+	// ErrOrderConflict is returned in strict mode when the order by conflicts with the cursor order.
+	ErrOrderConflict = errors.New("order by conflicts with the cursor order")
+	// ErrCursorValuesNotCaptured is recorded when a cursor pagination query is scanned with Scan or Rows,
+	// since the rows are scanned after the callbacks, so the next page can't be retrieved. Use Find instead.
+	ErrCursorValuesNotCaptured = errors.New("cursor values are only captured with find")
+)
+
+var (
+	_ error = CursorColumnNotInSchemaError{}
+	_ error = UnsupportedDestinationError{}
+)
+
+// CursorColumnNotInSchemaError is recorded when a cursor column is not a field of the model schema,
+// so its value can't be captured from the results.
+type CursorColumnNotInSchemaError struct {
+	Column string
+	Table  string
+}
+
+// Error returns the error message.
+func (c CursorColumnNotInSchemaError) Error() string {
+	return fmt.Sprintf("cursor column %q is not a field of the %q schema", c.Column, c.Table)
+}
+
+// UnsupportedDestinationError is recorded when the cursor values can't be captured from the query destination,
+// because it is not a pointer to a slice of structs or struct pointers.
+type UnsupportedDestinationError struct {
+	Type reflect.Type
+}
+
+// Error returns the error message.
+func (u UnsupportedDestinationError) Error() string {
+	return fmt.Sprintf("destination %v is not a pointer to a slice of structs", u.Type)
+}
@@ -37,23 +37,15 @@ func main() {
 
 	fmt.Printf("%s product created\n", length)
 
-	var products []*Product
 	pageRequest, _ := pagepagination.New(0, 5)
-	db.Clauses(pageRequest).Find(&products)
-
-	totalElements1, _ := pageRequest.TotalElements()
-	fmt.Printf("PageRequest result:(Page: %d, Size: %d, TotalElements: %d, TotalPages: %d)\n",
-		pageRequest.Page(), pageRequest.Size(), totalElements1, pageRequest.TotalPages())
-	for _, product := range products {
-		fmt.Printf("\t Product: %s\n", product)
-	}
-
-	pageRequest, _ = pageRequest.Next()
-	db.Clauses(pageRequest).Find(&products)
-	totalElements2, _ := pageRequest.TotalElements()
-	fmt.Printf("PageRequest result:(Page: %d, Size: %d, TotalElements: %d, TotalPages: %d)\n",
-		pageRequest.Page(), pageRequest.Size(), totalElements2, pageRequest.TotalPages())
-	for _, product := range products {
-		fmt.Printf("\t Product: %s\n", product)
+	for products, err := range pagorminator.Pages[*Product](db, pageRequest) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Page with %d products\n", len(products))
+		for _, product := range products {
+			fmt.Printf("\t Product: %s\n", product)
+		}
 	}
 }
@@ -0,0 +1,46 @@
+package pagorminator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+)
+
+// Find applies the pagination, runs the query and returns the page with the rows
+// and a snapshot of the pagination metadata.
+// Unlike db.Clauses(pagination).Find(&rows), the configuration problems are returned as errors.
+//
+// Errors:
+//   - ErrPaginationRequired if the pagination is nil.
+//   - ErrPluginNotRegistered if the PaGorminator plugin is not registered in db.
+//   - ErrTotalElementsNotSet if the total elements could not be counted, unless the count mode is CountSkip.
+//   - CursorColumnNotInSchemaError if a cursor column is not a field of T.
+//   - Any error returned by the query.
+func Find[T any](ctx context.Context, db *gorm.DB, pagination Pagination) (Page[T], error) {
+	if value := reflect.ValueOf(pagination); !value.IsValid() || value.Kind() == reflect.Pointer && value.IsNil() {
+		return Page[T]{}, ErrPaginationRequired
+	}
+
+	if _, ok := db.Plugins[PaGorminator{}.Name()]; !ok {
+		return Page[T]{}, ErrPluginNotRegistered
+	}
+
+	tx := db.WithContext(ctx)
+
+	var content []T
+	if err := tx.Clauses(pagination).Find(&content).Error; err != nil {
+		return Page[T]{}, fmt.Errorf("finding page: %w", err)
+	}
+
+	if errPagination, ok := pagination.(interface{ Err() error }); ok && errPagination.Err() != nil {
+		return Page[T]{}, errPagination.Err()
+	}
+
+	if !pagination.IsTotalElementsSet() && countModeOf(tx) != CountSkip {
+		return Page[T]{}, ErrTotalElementsNotSet
+	}
+
+	return NewPage(content, pagination)
+}
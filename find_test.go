@@ -0,0 +1,112 @@
+package pagorminator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	toMigrate := []*TestStruct{
+		{Model: gorm.Model{ID: 1}, Code: "A", Price: 1},
+		{Model: gorm.Model{ID: 2}, Code: "B", Price: 2},
+		{Model: gorm.Model{ID: 3}, Code: "C", Price: 3},
+	}
+
+	if txCreate := db.CreateInBatches(&toMigrate, len(toMigrate)); txCreate.Error != nil {
+		t.Fatal(txCreate.Error)
+	}
+
+	t.Run("page pagination", func(t *testing.T) {
+		t.Parallel()
+
+		pageRequest := pagepagination.Must(1, 2, pagegeneric.Asc("code"))
+
+		got, err := Find[TestStruct](t.Context(), db, pageRequest)
+		if err != nil {
+			t.Fatalf("Find() unexpected error: %v", err)
+		}
+
+		want := Page[TestStruct]{
+			Content:       []TestStruct{{Code: "C", Price: 3}},
+			Page:          1,
+			Size:          2,
+			TotalElements: 3,
+			TotalPages:    2,
+			HasNext:       false,
+			HasPrev:       true,
+		}
+		if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(TestStruct{}, "Model")); diff != "" {
+			t.Errorf("Find() diff (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("cursor pagination with value rows", func(t *testing.T) {
+		t.Parallel()
+
+		cursorRequest := cursorpagination.Must(2, cursorpagination.Asc("id", nil))
+
+		got, err := Find[TestStruct](t.Context(), db, cursorRequest)
+		if err != nil {
+			t.Fatalf("Find() unexpected error: %v", err)
+		}
+
+		if len(got.Content) != 2 || !got.HasNext || got.NextCursor == "" || got.TotalElements != 3 {
+			t.Errorf("Find() = %+v, want 2 rows with next cursor", got)
+		}
+	})
+}
+
+func TestFindConfigurationErrors(t *testing.T) {
+	t.Parallel()
+
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
+	if err != nil {
+		t.Fatal("failed to connect database")
+	}
+
+	tests := map[string]struct {
+		db         *gorm.DB
+		pagination Pagination
+		wantErr    error
+	}{
+		"plugin not registered": {
+			db:         db,
+			pagination: pagepagination.Must(0, 10),
+			wantErr:    ErrPluginNotRegistered,
+		},
+		"nil pagination": {
+			db:         setupDB(t),
+			pagination: nil,
+			wantErr:    ErrPaginationRequired,
+		},
+		"typed nil pagination": {
+			db:         setupDB(t),
+			pagination: (*pagepagination.Pagination)(nil),
+			wantErr:    ErrPaginationRequired,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotErr := Find[TestStruct](t.Context(), test.db, test.pagination)
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("Find() = %v, want %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package httpbind
+
+import (
+	"cmp"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+const (
+	// DefaultPageParam is the default page query parameter name.
+	DefaultPageParam = "page"
+	// DefaultSizeParam is the default size query parameter name.
+	DefaultSizeParam = "size"
+	// DefaultSortParam is the default sort query parameter name.
+	DefaultSortParam = "sort"
+	// DefaultCursorParam is the default cursor query parameter name.
+	DefaultCursorParam = "cursor"
+	// DefaultSize is the default page size when the size parameter is not present.
+	DefaultSize = 20
+)
+
+// columnPattern are the column names accepted in the sort parameter, optionally qualified with the table.
+var columnPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// Binder binds the pagination query parameters.
+// The zero value uses the default parameter names and size.
+type Binder struct {
+	// PageParam is the page query parameter name, DefaultPageParam if empty.
+	PageParam string
+	// SizeParam is the size query parameter name, DefaultSizeParam if empty.
+	SizeParam string
+	// SortParam is the sort query parameter name, DefaultSortParam if empty.
+	// Each value is `column` or `column,asc|desc`, e.g. `?sort=price,desc&sort=id`.
+	SortParam string
+	// CursorParam is the cursor query parameter name, DefaultCursorParam if empty.
+	CursorParam string
+	// DefaultSize is the size when the size parameter is not present, DefaultSize if zero.
+	DefaultSize int
+	// MaxSize is the maximum size allowed, no maximum if zero.
+	MaxSize int
+	// AllowedSorts are the columns allowed in the sort parameter. If empty, any column name is allowed.
+	AllowedSorts []string
+	// DefaultSort is the sort when the sort parameter is not present.
+	DefaultSort pagegeneric.Sort
+	// Cursors is the cursors definition for cursor pagination, their values are taken from the cursor parameter.
+	Cursors []cursorpagination.Cursor
+}
+
+// Page binds the page, size and sort parameters to a page pagination.
+//
+// Errors:
+//   - BindError with the errors of each parameter.
+func (b Binder) Page(values url.Values) (*pagepagination.Pagination, error) {
+	var bindError BindError
+
+	page := BindInt(&bindError, values, b.pageParam(), 0)
+	if page < 0 {
+		bindError.AddParam(values, b.pageParam(), pagepagination.ErrPageCantBeNegative)
+	}
+
+	size := b.bindSize(&bindError, values, pagepagination.ErrSizeCantBeNegative)
+	sort := b.bindSort(&bindError, values)
+
+	if len(bindError.Errors) > 0 {
+		return nil, bindError
+	}
+
+	pagination, err := pagepagination.New(page, size, sort...)
+	if err != nil {
+		bindError.AddParam(values, b.sizeParam(), err)
+
+		return nil, bindError
+	}
+
+	return pagination, nil
+}
+
+// PageFromRequest binds the page, size and sort query parameters of the request to a page pagination.
+//
+// Errors:
+//   - BindError with the errors of each parameter.
+func (b Binder) PageFromRequest(r *http.Request) (*pagepagination.Pagination, error) {
+	return b.Page(r.URL.Query())
+}
+
+// Cursor binds the size and cursor parameters to a cursor pagination using the Cursors definition.
+//
+// Errors:
+//   - BindError with the errors of each parameter.
+func (b Binder) Cursor(values url.Values) (*cursorpagination.Pagination, error) {
+	var bindError BindError
+
+	size := b.bindSize(&bindError, values, cursorpagination.ErrSizeCantBeNegative)
+	token := values.Get(b.cursorParam())
+
+	// the token is validated even if the size is not, to report all the errors at once.
+	pagination, err := cursorpagination.FromToken(max(size, 0), token, b.Cursors...)
+	if err != nil {
+		bindError.Add(b.cursorParam(), token, err)
+	}
+
+	if len(bindError.Errors) > 0 {
+		return nil, bindError
+	}
+
+	return pagination, nil
+}
+
+// CursorFromRequest binds the size and cursor query parameters of the request to a cursor pagination.
+//
+// Errors:
+//   - BindError with the errors of each parameter.
+func (b Binder) CursorFromRequest(r *http.Request) (*cursorpagination.Pagination, error) {
+	return b.Cursor(r.URL.Query())
+}
+
+func (b Binder) bindSize(bindError *BindError, values url.Values, errNegative error) int {
+	size := BindInt(bindError, values, b.sizeParam(), b.defaultSize())
+
+	switch {
+	case size < 0:
+		bindError.AddParam(values, b.sizeParam(), errNegative)
+	case size == 0:
+		bindError.AddParam(values, b.sizeParam(), ErrSizeCantBeZero)
+	case b.MaxSize > 0 && size > b.MaxSize:
+		bindError.AddParam(values, b.sizeParam(), ErrSizeTooBig)
+	}
+
+	return size
+}
+
+func (b Binder) bindSort(bindError *BindError, values url.Values) pagegeneric.Sort {
+	rawSorts, ok := values[b.sortParam()]
+	if !ok {
+		return b.DefaultSort
+	}
+
+	sort := make(pagegeneric.Sort, 0, len(rawSorts))
+
+	for _, raw := range rawSorts {
+		column, direction, _ := strings.Cut(raw, ",")
+		column = strings.TrimSpace(column)
+
+		switch {
+		case !IsColumnName(column):
+			bindError.Add(b.sortParam(), raw, ErrSortNotValid)
+
+			continue
+		case len(b.AllowedSorts) > 0 && !slices.Contains(b.AllowedSorts, column):
+			bindError.Add(b.sortParam(), raw, ErrSortNotAllowed)
+
+			continue
+		}
+
+		switch strings.ToLower(strings.TrimSpace(direction)) {
+		case "", "asc":
+			sort = append(sort, pagegeneric.Asc(column))
+		case "desc":
+			sort = append(sort, pagegeneric.Desc(column))
+		default:
+			bindError.Add(b.sortParam(), raw, ErrSortNotValid)
+		}
+	}
+
+	return sort
+}
+
+// BindInt binds an integer query parameter, or returns the default value if it is not present.
+// If it is not a number, ErrNotANumber is added to the bind error and the default value is returned.
+func BindInt(bindError *BindError, values url.Values, param string, defaultValue int) int {
+	raw := values.Get(param)
+	if raw == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		bindError.Add(param, raw, ErrNotANumber)
+
+		return defaultValue
+	}
+
+	return value
+}
+
+// IsColumnName returns true if the name is a column name accepted in the sort parameters,
+// optionally qualified with the table, e.g. `price` or `products.price`.
+func IsColumnName(name string) bool {
+	return columnPattern.MatchString(name)
+}
+
+func (b Binder) pageParam() string {
+	return cmp.Or(b.PageParam, DefaultPageParam)
+}
+
+func (b Binder) sizeParam() string {
+	return cmp.Or(b.SizeParam, DefaultSizeParam)
+}
+
+func (b Binder) sortParam() string {
+	return cmp.Or(b.SortParam, DefaultSortParam)
+}
+
+func (b Binder) cursorParam() string {
+	return cmp.Or(b.CursorParam, DefaultCursorParam)
+}
+
+func (b Binder) defaultSize() int {
+	return cmp.Or(b.DefaultSize, DefaultSize)
+}
@@ -0,0 +1,223 @@
+package httpbind
+
+import (
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestBinderPage(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		binder   Binder
+		query    string
+		wantPage int
+		wantSize int
+		wantSort pagegeneric.Sort
+		wantErrs map[string][]error
+	}{
+		"defaults": {
+			query:    "",
+			wantPage: 0,
+			wantSize: DefaultSize,
+		},
+		"page, size and sorts": {
+			query:    "page=2&size=5&sort=price,desc&sort=id",
+			wantPage: 2,
+			wantSize: 5,
+			wantSort: pagegeneric.Sort{pagegeneric.Desc("price"), pagegeneric.Asc("id")},
+		},
+		"custom parameter names and default sort": {
+			binder: Binder{
+				PageParam:   "p",
+				SizeParam:   "limit",
+				DefaultSize: 50,
+				DefaultSort: pagegeneric.Sort{pagegeneric.Asc("id")},
+			},
+			query:    "p=1&page=7",
+			wantPage: 1,
+			wantSize: 50,
+			wantSort: pagegeneric.Sort{pagegeneric.Asc("id")},
+		},
+		"errors aggregated per parameter": {
+			binder: Binder{MaxSize: 100, AllowedSorts: []string{"price"}},
+			query:  "page=-1&size=101&sort=id&sort=price,up&sort=price%20drop",
+			wantErrs: map[string][]error{
+				"page": {pagepagination.ErrPageCantBeNegative},
+				"size": {ErrSizeTooBig},
+				"sort": {ErrSortNotAllowed, ErrSortNotValid, ErrSortNotValid},
+			},
+		},
+		"size zero": {
+			binder: Binder{MaxSize: 10},
+			query:  "size=0",
+			wantErrs: map[string][]error{
+				"size": {ErrSizeCantBeZero},
+			},
+		},
+		"not a number": {
+			query: "page=one&size=two",
+			wantErrs: map[string][]error{
+				"page": {ErrNotANumber},
+				"size": {ErrNotANumber},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, _ := url.ParseQuery(test.query)
+
+			got, err := test.binder.Page(values)
+			if test.wantErrs != nil {
+				var bindError BindError
+				if !errors.As(err, &bindError) {
+					t.Fatalf("Page(%q) = %v, want BindError", test.query, err)
+				}
+
+				if diff := cmp.Diff(test.wantErrs, bindError.ByParam(), cmp.Comparer(errors.Is)); diff != "" {
+					t.Errorf("Page(%q) errors diff (-want +got):\n%s", test.query, diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Page(%q) unexpected error: %v", test.query, err)
+			}
+
+			if got.Page() != test.wantPage || got.Size() != test.wantSize {
+				t.Errorf("Page(%q) = (%d, %d), want (%d, %d)", test.query, got.Page(), got.Size(), test.wantPage, test.wantSize)
+			}
+
+			if diff := cmp.Diff(test.wantSort, got.Sort(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Page(%q) sort diff (-want +got):\n%s", test.query, diff)
+			}
+		})
+	}
+}
+
+func TestBinderCursor(t *testing.T) {
+	t.Parallel()
+
+	binder := Binder{
+		MaxSize: 100,
+		Cursors: []cursorpagination.Cursor{cursorpagination.Desc("price", nil), cursorpagination.Asc("id", nil)},
+	}
+
+	token, err := cursorpagination.Must(10, cursorpagination.Desc("price", 20), cursorpagination.Asc("id", 3)).Token()
+	if err != nil {
+		t.Fatalf("Token() unexpected error: %v", err)
+	}
+
+	t.Run("first page", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := binder.CursorFromRequest(httptest.NewRequest("GET", "/products?size=10", nil))
+		if err != nil {
+			t.Fatalf("CursorFromRequest() unexpected error: %v", err)
+		}
+
+		if got.Size() != 10 || got.Cursors()[0].Value() != nil {
+			t.Errorf("CursorFromRequest() = %+v, want size 10 without cursor values", got.Cursors())
+		}
+	})
+
+	t.Run("next page", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := binder.Cursor(url.Values{"cursor": {token}})
+		if err != nil {
+			t.Fatalf("Cursor() unexpected error: %v", err)
+		}
+
+		if got.Size() != DefaultSize || got.Cursors()[0].Value() != int64(20) || got.Cursors()[1].Value() != int64(3) {
+			t.Errorf("Cursor() = %d %+v, want the token values", got.Size(), got.Cursors())
+		}
+	})
+
+	t.Run("invalid cursor and size", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := binder.Cursor(url.Values{"cursor": {"invalid"}, "size": {"1000"}})
+
+		var bindError BindError
+		if !errors.As(err, &bindError) {
+			t.Fatalf("Cursor() = %v, want BindError", err)
+		}
+
+		byParam := bindError.ByParam()
+		if len(byParam["size"]) != 1 || len(byParam["cursor"]) != 1 {
+			t.Errorf("Cursor() = %v, want size and cursor errors", err)
+		}
+	})
+
+	t.Run("size zero", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := binder.Cursor(url.Values{"size": {"0"}})
+		if !errors.Is(err, ErrSizeCantBeZero) {
+			t.Errorf("Cursor() = %v, want %v", err, ErrSizeCantBeZero)
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := binder.Cursor(url.Values{"cursor": {"invalid"}})
+		if !errors.Is(err, cursorpagination.ErrTokenNotValid) {
+			t.Errorf("Cursor() = %v, want %v", err, cursorpagination.ErrTokenNotValid)
+		}
+	})
+}
+
+func TestBindInt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values   url.Values
+		want     int
+		wantErrs []ParamError
+	}{
+		"not present": {
+			values: url.Values{},
+			want:   7,
+		},
+		"number": {
+			values: url.Values{"n": {"3"}},
+			want:   3,
+		},
+		"not a number": {
+			values:   url.Values{"n": {"three"}},
+			want:     7,
+			wantErrs: []ParamError{{Param: "n", Value: "three", Err: ErrNotANumber}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var bindError BindError
+
+			if got := BindInt(&bindError, test.values, "n", 7); got != test.want {
+				t.Errorf("BindInt() = %d, want %d", got, test.want)
+			}
+
+			if diff := cmp.Diff(test.wantErrs, bindError.Errors, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("BindInt() errors diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Package httpbind binds the pagination query parameters of HTTP requests,
+// e.g. `?page=1&size=20&sort=price,desc` or `?size=20&cursor=<token>`,
+// to page-based and cursor-based paginations.
+// The errors are aggregated per parameter, so they can be returned as a 400 Bad Request.
+package httpbind
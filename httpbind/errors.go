@@ -0,0 +1,87 @@
+package httpbind
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrNotANumber is returned when a numeric parameter can't be parsed.
+	ErrNotANumber = errors.New("not a number")
+	// ErrSizeCantBeZero is returned when the size is zero, that would request all the rows unpaged.
+	ErrSizeCantBeZero = errors.New("size can't be zero")
+	// ErrSizeTooBig is returned when the size is above the max size.
+	ErrSizeTooBig = errors.New("size is too big")
+	// ErrSortNotValid is returned when the sort parameter is not `column` or `column,direction`.
+	ErrSortNotValid = errors.New("sort is not valid")
+	// ErrSortNotAllowed is returned when the sort column is not allowed.
+	ErrSortNotAllowed       = errors.New("sort is not allowed")
+	_                 error = new(ParamError)
+	_                 error = new(BindError)
+)
+
+type (
+	// ParamError is an error binding a query parameter.
+	ParamError struct {
+		Param string
+		Value string
+		Err   error
+	}
+
+	// BindError aggregates the errors binding the query parameters.
+	BindError struct {
+		Errors []ParamError
+	}
+)
+
+// Error returns the error message.
+func (e ParamError) Error() string {
+	return fmt.Sprintf("parameter %q with value %q: %v", e.Param, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e ParamError) Unwrap() error {
+	return e.Err
+}
+
+// Error returns the error message.
+func (e BindError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, paramError := range e.Errors {
+		messages[i] = paramError.Error()
+	}
+
+	return "binding pagination: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the errors of each parameter.
+func (e BindError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, paramError := range e.Errors {
+		errs[i] = paramError
+	}
+
+	return errs
+}
+
+// Add adds the error of a parameter with its value.
+func (e *BindError) Add(param, value string, err error) {
+	e.Errors = append(e.Errors, ParamError{Param: param, Value: value, Err: err})
+}
+
+// AddParam adds the error of a parameter, with its first value in the query parameters.
+func (e *BindError) AddParam(values url.Values, param string, err error) {
+	e.Add(param, values.Get(param), err)
+}
+
+// ByParam returns the errors grouped by parameter name.
+func (e BindError) ByParam() map[string][]error {
+	byParam := make(map[string][]error, len(e.Errors))
+	for _, paramError := range e.Errors {
+		byParam[paramError.Param] = append(byParam[paramError.Param], paramError.Err)
+	}
+
+	return byParam
+}
@@ -0,0 +1,210 @@
+package httpbind
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+const (
+	// LinkHeader is the RFC 8288 Link header name.
+	LinkHeader = "Link"
+	// TotalCountHeader is the header name with the total elements.
+	TotalCountHeader = "X-Total-Count"
+)
+
+type (
+	// Link is a pagination link with its relation type, e.g. `next`.
+	Link struct {
+		Rel string
+		URL string
+	}
+
+	// PageLinkParams are the query parameters of the page links.
+	PageLinkParams struct {
+		// Page is the page number query parameter name.
+		Page string
+		// Size is the page size query parameter name.
+		Size string
+		// FirstPage is the number of the first page, e.g. 1 for one-based page numbers.
+		FirstPage int
+	}
+)
+
+// Links returns the RFC 8288 Link header value, with the first, prev, next and last relations
+// of a pagination that has run, e.g. `<https://api.example.com/products?page=1&size=10>; rel="next"`.
+// The other query parameters of the base URL are preserved.
+// The last relation is left out if the total elements are not set, and unpaged paginations have no links.
+// Cursor paginations only have the first and next relations.
+//
+// Errors:
+//   - Any error encoding the cursor tokens.
+func (b Binder) Links(base *url.URL, pagination pagorminator.Pagination) (string, error) {
+	var links []Link
+
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination, *idpagination.Pagination:
+		links = PageLinks(base, typed, PageLinkParams{Page: b.pageParam(), Size: b.sizeParam(), FirstPage: 0})
+	case *cursorpagination.Pagination:
+		var err error
+
+		links, err = b.cursorLinks(base, typed)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	formatted := make([]string, len(links))
+	for i, l := range links {
+		formatted[i] = fmt.Sprintf("<%s>; rel=%q", l.URL, l.Rel)
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// SetHeaders sets the Link and X-Total-Count headers of a pagination that has run.
+// The X-Total-Count header is not set if the total elements are not set.
+//
+// Errors:
+//   - Any error encoding the cursor tokens.
+func (b Binder) SetHeaders(header http.Header, base *url.URL, pagination pagorminator.Pagination) error {
+	links, err := b.Links(base, pagination)
+	if err != nil {
+		return err
+	}
+
+	if links != "" {
+		header.Set(LinkHeader, links)
+	}
+
+	if totalElements, ok := pagination.TotalElements(); ok {
+		header.Set(TotalCountHeader, strconv.FormatInt(totalElements, 10))
+	}
+
+	return nil
+}
+
+// pageNumbers are the page numbers linked from a page or id pagination.
+type pageNumbers struct {
+	size, prev, next, last    int
+	hasPrev, hasNext, hasLast bool
+}
+
+// pageNumbersOf returns the page numbers linked from a page or id pagination.
+// The last page is only linked if the total elements are set, e.g. not with pagorminator.CountSkip.
+// The prev and next pages are the ones of the pagination Prev and Next, so they are valid pages
+// even if the offset is not a multiple of the size, e.g. with pagepagination.NewOffset.
+func pageNumbersOf(pagination pagorminator.Pagination) pageNumbers {
+	_, hasTotalElements := pagination.TotalElements()
+	pages := pageNumbers{hasLast: hasTotalElements}
+
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination:
+		pages.size, pages.last = typed.Size(), max(typed.TotalPages()-1, 0)
+		if prev, ok := typed.Prev(); ok {
+			pages.prev, pages.hasPrev = prev.Page(), true
+		}
+
+		if next, ok := typed.Next(); ok {
+			pages.next, pages.hasNext = next.Page(), true
+		}
+	case *idpagination.Pagination:
+		pages.size, pages.last = typed.Size(), max(typed.TotalPages()-1, 0)
+		if prev, ok := typed.Prev(); ok {
+			pages.prev, pages.hasPrev = prev.Page(), true
+		}
+
+		if next, ok := typed.Next(); ok {
+			pages.next, pages.hasNext = next.Page(), true
+		}
+	}
+
+	return pages
+}
+
+// PageLinks returns the first, prev, next and last links of a page or id pagination that has run,
+// none for other paginations. The other query parameters of the base URL are preserved.
+// The last link is left out if the total elements are not set, and unpaged paginations have no links,
+// since a zero size is not bound.
+func PageLinks(base *url.URL, pagination pagorminator.Pagination, params PageLinkParams) []Link {
+	pages := pageNumbersOf(pagination)
+	if pages.size == 0 {
+		return nil
+	}
+
+	pageURL := func(page int) string {
+		return WithQuery(base, map[string]string{
+			params.Page: strconv.Itoa(page + params.FirstPage),
+			params.Size: strconv.Itoa(pages.size),
+		})
+	}
+
+	links := []Link{{Rel: "first", URL: pageURL(0)}}
+	if pages.hasPrev {
+		links = append(links, Link{Rel: "prev", URL: pageURL(pages.prev)})
+	}
+
+	if pages.hasNext {
+		links = append(links, Link{Rel: "next", URL: pageURL(pages.next)})
+	}
+
+	if pages.hasLast {
+		links = append(links, Link{Rel: "last", URL: pageURL(pages.last)})
+	}
+
+	return links
+}
+
+// cursorLinks returns the cursor links, none if the pagination is unpaged, since the binder rejects a zero size.
+func (b Binder) cursorLinks(base *url.URL, pagination *cursorpagination.Pagination) ([]Link, error) {
+	if pagination.Size() == 0 {
+		return nil, nil
+	}
+
+	size := strconv.Itoa(pagination.Size())
+	links := []Link{{
+		Rel: "first",
+		URL: WithQuery(base, map[string]string{b.sizeParam(): size, b.cursorParam(): ""}),
+	}}
+
+	next, hasNext := pagination.Next()
+	if !hasNext {
+		return links, nil
+	}
+
+	token, err := next.Token()
+	if err != nil {
+		return nil, fmt.Errorf("encoding next cursor: %w", err)
+	}
+
+	return append(links, Link{
+		Rel: "next",
+		URL: WithQuery(base, map[string]string{b.sizeParam(): size, b.cursorParam(): token}),
+	}), nil
+}
+
+// WithQuery returns the base URL with the params set, removing the ones with empty values.
+// The other query parameters of the base URL are preserved.
+func WithQuery(base *url.URL, params map[string]string) string {
+	withParams := *base
+	query := withParams.Query()
+
+	for param, value := range params {
+		if value == "" {
+			query.Del(param)
+		} else {
+			query.Set(param, value)
+		}
+	}
+
+	withParams.RawQuery = query.Encode()
+
+	return withParams.String()
+}
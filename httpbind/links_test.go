@@ -0,0 +1,125 @@
+package httpbind
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestBinderLinks(t *testing.T) {
+	t.Parallel()
+
+	base, _ := url.Parse("https://api.example.com/products?q=shoes&page=1&size=2&sort=price,desc")
+
+	nextToken, err := cursorpagination.Must(2, cursorpagination.Asc("id", 4)).Token()
+	if err != nil {
+		t.Fatalf("Token() unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		binder         Binder
+		pagination     func() pagorminator.Pagination
+		wantLink       string
+		wantTotalCount string
+	}{
+		"middle page": {
+			pagination: func() pagorminator.Pagination {
+				p := pagepagination.Must(1, 2, pagegeneric.Desc("price"))
+				_ = p.SetTotalElements(5)
+
+				return p
+			},
+			wantLink: `<https://api.example.com/products?page=0&q=shoes&size=2&sort=price%2Cdesc>; rel="first", ` +
+				`<https://api.example.com/products?page=0&q=shoes&size=2&sort=price%2Cdesc>; rel="prev", ` +
+				`<https://api.example.com/products?page=2&q=shoes&size=2&sort=price%2Cdesc>; rel="next", ` +
+				`<https://api.example.com/products?page=2&q=shoes&size=2&sort=price%2Cdesc>; rel="last"`,
+			wantTotalCount: "5",
+		},
+		"offset not multiple of the size": {
+			pagination: func() pagorminator.Pagination {
+				p, _ := pagepagination.NewOffset(5, 10)
+				_ = p.SetTotalElements(30)
+
+				return p
+			},
+			wantLink: `<https://api.example.com/products?page=0&q=shoes&size=10&sort=price%2Cdesc>; rel="first", ` +
+				`<https://api.example.com/products?page=0&q=shoes&size=10&sort=price%2Cdesc>; rel="prev", ` +
+				`<https://api.example.com/products?page=1&q=shoes&size=10&sort=price%2Cdesc>; rel="next", ` +
+				`<https://api.example.com/products?page=2&q=shoes&size=10&sort=price%2Cdesc>; rel="last"`,
+			wantTotalCount: "30",
+		},
+		"count skipped": {
+			pagination: func() pagorminator.Pagination { return pagepagination.Must(1, 2) },
+			wantLink:   `<https://api.example.com/products?page=0&q=shoes&size=2&sort=price%2Cdesc>; rel="first"`,
+		},
+		"unpaged": {
+			pagination: func() pagorminator.Pagination {
+				p := pagepagination.UnPaged()
+				_ = p.SetTotalElements(5)
+
+				return p
+			},
+			wantTotalCount: "5",
+		},
+		"cursor unpaged": {
+			pagination: func() pagorminator.Pagination { return cursorpagination.UnPaged() },
+		},
+		"single page with custom parameter names": {
+			binder: Binder{PageParam: "p", SizeParam: "limit"},
+			pagination: func() pagorminator.Pagination {
+				p := pagepagination.Must(0, 10)
+				_ = p.SetTotalElements(3)
+
+				return p
+			},
+			wantLink: `<https://api.example.com/products?limit=10&p=0&page=1&q=shoes&size=2&sort=price%2Cdesc>; rel="first", ` +
+				`<https://api.example.com/products?limit=10&p=0&page=1&q=shoes&size=2&sort=price%2Cdesc>; rel="last"`,
+			wantTotalCount: "3",
+		},
+		"cursor with next page": {
+			pagination: func() pagorminator.Pagination {
+				p := cursorpagination.Must(2, cursorpagination.Asc("id", nil))
+				p.SetLatestQueryValues(2, map[string]any{"id": 4})
+				_ = p.SetTotalElements(5)
+
+				return p
+			},
+			wantLink: `<https://api.example.com/products?page=1&q=shoes&size=2&sort=price%2Cdesc>; rel="first", ` +
+				`<https://api.example.com/products?cursor=` + nextToken + `&page=1&q=shoes&size=2&sort=price%2Cdesc>; rel="next"`,
+			wantTotalCount: "5",
+		},
+		"cursor without total elements": {
+			pagination: func() pagorminator.Pagination {
+				p := cursorpagination.Must(2, cursorpagination.Asc("id", nil))
+				p.SetLatestQueryValues(1, map[string]any{"id": 4})
+
+				return p
+			},
+			wantLink: `<https://api.example.com/products?page=1&q=shoes&size=2&sort=price%2Cdesc>; rel="first"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			header := http.Header{}
+			if err := test.binder.SetHeaders(header, base, test.pagination()); err != nil {
+				t.Fatalf("SetHeaders() unexpected error: %v", err)
+			}
+
+			if got := header.Get(LinkHeader); got != test.wantLink {
+				t.Errorf("Link = %s\nwant %s", got, test.wantLink)
+			}
+
+			if got := header.Get(TotalCountHeader); got != test.wantTotalCount {
+				t.Errorf("X-Total-Count = %q, want %q", got, test.wantTotalCount)
+			}
+		})
+	}
+}
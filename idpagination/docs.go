@@ -0,0 +1,7 @@
+// Package idpagination contains the implementation of pagination
+// over an externally ranked list of ids for GORM, e.g. the results of a search service.
+// The package includes the `Pagination` struct,
+// which allows you to specify the ordered ids, the size of the page and
+// the page number to use for pagination.
+// The page window of ids is fetched with `WHERE id IN (...)`, preserving the external order.
+package idpagination
@@ -0,0 +1,14 @@
+package idpagination
+
+import "errors"
+
+var (
+	// ErrPageCantBeNegative is an error type that represents an invalid page value.
+	ErrPageCantBeNegative = errors.New("page number can't be negative")
+	// ErrSizeCantBeNegative is an error type that represents an invalid size value.
+	ErrSizeCantBeNegative = errors.New("size can't be negative")
+	// ErrSizeNotAllowed is an error type that represents an invalid size value.
+	ErrSizeNotAllowed = errors.New("size is not allowed")
+	// ErrColumnRequired is an error type that represents a missing id column.
+	ErrColumnRequired = errors.New("column is required")
+)
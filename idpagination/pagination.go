@@ -0,0 +1,218 @@
+package idpagination
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/manuelarte/pagorminator/pagegeneric"
+)
+
+// Pagination Clause to apply pagination over an ordered list of ids.
+//
+//go:structinit
+type Pagination struct {
+	page   int
+	size   int
+	column string
+	ids    []any
+
+	mu               sync.RWMutex
+	totalElements    int64
+	totalElementsSet bool
+}
+
+// New Create page given page, size, the id column and the ordered ids.
+// The repeated ids are removed, keeping the position of their first occurrence,
+// and the total elements are the number of ids, so no count query is needed.
+// It returns the pagination object and any error encountered.
+//
+// Errors:
+//   - ErrPageCantBeNegative if the page value is below zero.
+//   - ErrSizeCantBeNegative if the size value is below zero.
+//   - ErrSizeNotAllowed if the size is zero and the page is greater than zero.
+//   - ErrColumnRequired if the column is empty.
+func New(page, size int, column string, ids ...any) (*Pagination, error) {
+	if page < 0 {
+		return nil, ErrPageCantBeNegative
+	}
+
+	if size < 0 {
+		return nil, ErrSizeCantBeNegative
+	}
+
+	if page > 0 && size == 0 {
+		return nil, ErrSizeNotAllowed
+	}
+
+	if column == "" {
+		return nil, ErrColumnRequired
+	}
+
+	ids = dedupIDs(ids)
+
+	return &Pagination{
+		page:             page,
+		size:             size,
+		column:           column,
+		ids:              ids,
+		totalElements:    int64(len(ids)),
+		totalElementsSet: true,
+	}, nil
+}
+
+// Must Create page given page, size, the id column and the ordered ids.
+// It returns the pagination object or panic if any error is encountered.
+func Must(page, size int, column string, ids ...any) *Pagination {
+	pagination, err := New(page, size, column, ids...)
+	if err != nil {
+		panic(err)
+	}
+
+	return pagination
+}
+
+// dedupIDs returns a copy of the ids without the repeated ones, keeping the first occurrence.
+// The ids that are not comparable, e.g. byte slices, are kept.
+func dedupIDs(ids []any) []any {
+	seen := make(map[any]struct{}, len(ids))
+	unique := make([]any, 0, len(ids))
+
+	for _, id := range ids {
+		if id != nil && !reflect.TypeOf(id).Comparable() {
+			unique = append(unique, id)
+
+			continue
+		}
+
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return unique
+}
+
+// Page Get the page number.
+func (p *Pagination) Page() int {
+	return p.page
+}
+
+// Size Get the page size.
+func (p *Pagination) Size() int {
+	return p.size
+}
+
+// Column Get the id column.
+func (p *Pagination) Column() string {
+	return p.column
+}
+
+// IDs Get all the ordered ids.
+func (p *Pagination) IDs() []any {
+	return slices.Clone(p.ids)
+}
+
+// PageIDs Get the ordered ids of the page window.
+func (p *Pagination) PageIDs() []any {
+	if p.IsUnPaged() {
+		return slices.Clone(p.ids)
+	}
+
+	start := min(p.Offset(), len(p.ids))
+	end := min(start+p.size, len(p.ids))
+
+	return slices.Clone(p.ids[start:end])
+}
+
+// Sort Get the sort constraints, the position of the ids in the page window.
+func (p *Pagination) Sort() pagegeneric.Sort {
+	return pagegeneric.NewSort(pagegeneric.NewValueList(p.column, p.PageIDs()...))
+}
+
+// Offset Get the offset.
+func (p *Pagination) Offset() int {
+	return p.page * p.size
+}
+
+// TotalPages Get the total number of pages.
+func (p *Pagination) TotalPages() int {
+	if p.size > 0 {
+		return calculateTotalPages(int64(len(p.ids)), p.size)
+	}
+
+	return 1
+}
+
+// TotalElements returns the total elements, the number of ids.
+func (p *Pagination) TotalElements() (int64, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.totalElements, p.totalElementsSet
+}
+
+// SetTotalElements sets the total elements.
+//
+// Errors:
+//   - ErrTotalElementsNotValid if the total elements are below zero.
+func (p *Pagination) SetTotalElements(totalElements int64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if totalElements < 0 {
+		return pagegeneric.TotalElementsNotValidError{TotalElements: totalElements}
+	}
+
+	p.totalElementsSet = true
+	p.totalElements = totalElements
+
+	return nil
+}
+
+// IsUnPaged Check whether the pagination is applicable.
+func (p *Pagination) IsUnPaged() bool {
+	return p.page == 0 && p.size == 0
+}
+
+// IsTotalElementsSet Check whether the total elements are set.
+func (p *Pagination) IsTotalElementsSet() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.totalElementsSet
+}
+
+// Next Get the next page pagination request.
+//
+// Cases in which the next page could not be retrieved:
+//   - pagegeneric.NoNextPage if there is no next page.
+func (p *Pagination) Next() (*Pagination, pagegeneric.PrevNextPossible) {
+	nextPage := p.page + 1
+	if nextPage >= p.TotalPages() {
+		return nil, pagegeneric.NoNextPage
+	}
+
+	return Must(nextPage, p.size, p.column, p.ids...), true
+}
+
+// Prev Get the previous page pagination request.
+//
+// Cases in which the previous page could not be retrieved:
+//   - pagegeneric.NoPrevPage if there is no previous page.
+func (p *Pagination) Prev() (*Pagination, pagegeneric.PrevNextPossible) {
+	prevPage := p.page - 1
+	if prevPage < 0 {
+		return nil, pagegeneric.NoPrevPage
+	}
+
+	return Must(prevPage, p.size, p.column, p.ids...), true
+}
+
+func calculateTotalPages(totalElements int64, size int) int {
+	return int(math.Ceil(float64(totalElements) / float64(size)))
+}
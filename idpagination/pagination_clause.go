@@ -0,0 +1,27 @@
+package idpagination
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/manuelarte/pagorminator/pagegeneric"
+)
+
+// ModifyStatement Modify the query clause to fetch the ids of the page window in their order.
+func (p *Pagination) ModifyStatement(stm *gorm.Statement) {
+	tx := stm.DB
+	pagegeneric.SetClause(tx, p)
+
+	pageIDs := p.PageIDs()
+	tx = tx.Where(clause.IN{Column: clause.Column{Name: p.column, Raw: true}, Values: pageIDs})
+
+	if len(pageIDs) > 0 {
+		// the order by vars are bound by the plugin.
+		tx.Set(pagegeneric.PagorminatorSort, p.Sort())
+	}
+}
+
+// Build N/A for pagination.
+func (p *Pagination) Build(_ clause.Builder) {
+	// method needed to implement interface [clause.Expression]
+}
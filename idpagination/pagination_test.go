@@ -0,0 +1,175 @@
+package idpagination
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/manuelarte/pagorminator/pagegeneric"
+)
+
+func ExampleNew() {
+	page, err := New(1, 2, "id", 5, 3, 9, 1)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Page: %d, Size: %d, IDs: %v\n", page.Page(), page.Size(), page.PageIDs())
+	// Output: Page: 1, Size: 2, IDs: [9 1]
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		page        int
+		size        int
+		column      string
+		expectedErr error
+	}{
+		"negative page": {
+			page:        -1,
+			size:        1,
+			column:      "id",
+			expectedErr: ErrPageCantBeNegative,
+		},
+		"negative size": {
+			page:        0,
+			size:        -1,
+			column:      "id",
+			expectedErr: ErrSizeCantBeNegative,
+		},
+		"page without size": {
+			page:        1,
+			size:        0,
+			column:      "id",
+			expectedErr: ErrSizeNotAllowed,
+		},
+		"missing column": {
+			page:        0,
+			size:        1,
+			expectedErr: ErrColumnRequired,
+		},
+		"valid request": {
+			page:   0,
+			size:   1,
+			column: "id",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(test.page, test.size, test.column, 1, 2)
+			if !errors.Is(err, test.expectedErr) {
+				t.Errorf("New(%d, %d, %q) = %v, want %v", test.page, test.size, test.column, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPageIDs(t *testing.T) {
+	t.Parallel()
+
+	ids := []any{5, 3, 9, 1, 7}
+
+	tests := map[string]struct {
+		pagination *Pagination
+		want       []any
+	}{
+		"unpaged": {
+			pagination: Must(0, 0, "id", ids...),
+			want:       []any{5, 3, 9, 1, 7},
+		},
+		"first page": {
+			pagination: Must(0, 2, "id", ids...),
+			want:       []any{5, 3},
+		},
+		"last page, not full": {
+			pagination: Must(2, 2, "id", ids...),
+			want:       []any{7},
+		},
+		"page out of range": {
+			pagination: Must(3, 2, "id", ids...),
+			want:       []any{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(test.want, test.pagination.PageIDs()); diff != "" {
+				t.Errorf("PageIDs() diff (-want +got):\n%s", diff)
+			}
+
+			if totalElements, _ := test.pagination.TotalElements(); totalElements != int64(len(ids)) {
+				t.Errorf("TotalElements() = %d, want %d", totalElements, len(ids))
+			}
+		})
+	}
+}
+
+func TestNewDedupIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ids  []any
+		want []any
+	}{
+		"no repeated ids": {
+			ids:  []any{5, 3, 9},
+			want: []any{5, 3, 9},
+		},
+		"repeated ids keep the first occurrence": {
+			ids:  []any{5, 3, 5, 9, 3, 1},
+			want: []any{5, 3, 9, 1},
+		},
+		"not comparable ids are kept": {
+			ids:  []any{[]byte("a"), []byte("a"), "a", "a"},
+			want: []any{[]byte("a"), []byte("a"), "a"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pagination := Must(0, 2, "id", test.ids...)
+			if diff := cmp.Diff(test.want, pagination.IDs()); diff != "" {
+				t.Errorf("IDs() diff (-want +got):\n%s", diff)
+			}
+
+			if totalElements, _ := pagination.TotalElements(); totalElements != int64(len(test.want)) {
+				t.Errorf("TotalElements() = %d, want %d", totalElements, len(test.want))
+			}
+		})
+	}
+}
+
+func TestNextPrev(t *testing.T) {
+	t.Parallel()
+
+	p := Must(0, 2, "id", 5, 3, 9)
+
+	next, hasNext := p.Next()
+	if !hasNext || next.Page() != 1 {
+		t.Fatalf("Next() = %v, %v, want page 1", next, hasNext)
+	}
+
+	if _, hasNext = next.Next(); hasNext != pagegeneric.NoNextPage {
+		t.Errorf("Next() = _, %v, want %v", hasNext, pagegeneric.NoNextPage)
+	}
+
+	prev, hasPrev := next.Prev()
+	if !hasPrev || prev.Page() != 0 {
+		t.Fatalf("Prev() = %v, %v, want page 0", prev, hasPrev)
+	}
+
+	if _, hasPrev = prev.Prev(); hasPrev != pagegeneric.NoPrevPage {
+		t.Errorf("Prev() = _, %v, want %v", hasPrev, pagegeneric.NoPrevPage)
+	}
+}
@@ -0,0 +1,106 @@
+package pagorminator
+
+import (
+	"iter"
+
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+// All returns an iterator over the rows of all the pages, starting from the given pagination.
+// See Pages for how the pages are walked.
+func All[T any](db *gorm.DB, pagination Pagination) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for rows, err := range Pages[T](db, pagination) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+
+			for _, row := range rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pages returns an iterator over the rows of each page, starting from the given pagination.
+// The pages are walked with Next until there is no next page, the empty pages are not yielded.
+// The iteration stops after the first error, including the cancellation of the db statement context.
+//
+// Errors:
+//   - ErrPaginationNotIterable if the pagination type can't retrieve the next page.
+//   - Any error returned by Find.
+func Pages[T any](db *gorm.DB, pagination Pagination) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		if !isIterable(pagination) {
+			yield(nil, ErrPaginationNotIterable)
+
+			return
+		}
+
+		ctx := db.Statement.Context
+		for pagination != nil {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			page, err := Find[T](ctx, db, pagination)
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			if len(page.Content) > 0 && !yield(page.Content, nil) {
+				return
+			}
+
+			pagination = next(pagination)
+		}
+	}
+}
+
+func isIterable(pagination Pagination) bool {
+	switch pagination.(type) {
+	case *pagepagination.Pagination, *cursorpagination.Pagination, *idpagination.Pagination:
+		return true
+	default:
+		// nil is iterable, so Find returns ErrPaginationRequired.
+		return pagination == nil
+	}
+}
+
+// next returns the next pagination, or nil if there is no next page.
+func next(pagination Pagination) Pagination {
+	// Without size, the first page already contains all the rows.
+	if pagination.Size() == 0 {
+		return nil
+	}
+
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination:
+		if nextPage, ok := typed.Next(); ok {
+			return nextPage
+		}
+	case *cursorpagination.Pagination:
+		if nextPage, ok := typed.Next(); ok {
+			return nextPage
+		}
+	case *idpagination.Pagination:
+		if nextPage, ok := typed.Next(); ok {
+			return nextPage
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package pagorminator
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestPages(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		toMigrate  int
+		pagination Pagination
+		where      string
+		expected   [][]uint
+	}{
+		"page pagination, last page not full": {
+			toMigrate:  5,
+			pagination: pagepagination.Must(0, 2, pagegeneric.Asc("id")),
+			expected:   [][]uint{{1, 2}, {3, 4}, {5}},
+		},
+		"page pagination, starting in second page": {
+			toMigrate:  5,
+			pagination: pagepagination.Must(1, 2, pagegeneric.Asc("id")),
+			expected:   [][]uint{{3, 4}, {5}},
+		},
+		"page pagination, with where": {
+			toMigrate:  6,
+			pagination: pagepagination.Must(0, 2, pagegeneric.Asc("id")),
+			where:      "price > 2",
+			expected:   [][]uint{{3, 4}, {5, 6}},
+		},
+		"page pagination, unpaged": {
+			toMigrate:  3,
+			pagination: pagepagination.Must(0, 0, pagegeneric.Asc("id")),
+			expected:   [][]uint{{1, 2, 3}},
+		},
+		"page pagination, no rows": {
+			toMigrate:  0,
+			pagination: pagepagination.Must(0, 2),
+			expected:   nil,
+		},
+		"cursor pagination, last page full": {
+			toMigrate:  4,
+			pagination: cursorpagination.Must(2, cursorpagination.Asc("id", nil)),
+			expected:   [][]uint{{1, 2}, {3, 4}},
+		},
+		"cursor pagination, last page not full": {
+			toMigrate:  5,
+			pagination: cursorpagination.Must(2, cursorpagination.Desc("id", nil)),
+			expected:   [][]uint{{5, 4}, {3, 2}, {1}},
+		},
+		"id pagination": {
+			toMigrate:  5,
+			pagination: idpagination.Must(0, 2, "id", 5, 1, 3),
+			expected:   [][]uint{{5, 1}, {3}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db := setupDB(t)
+			migrateTestStructs(t, db, test.toMigrate)
+
+			tx := db
+			if test.where != "" {
+				tx = db.Where(test.where)
+			}
+
+			var got [][]uint
+			for rows, err := range Pages[TestStruct](tx, test.pagination) {
+				if err != nil {
+					t.Fatalf("Pages() unexpected error: %v", err)
+				}
+
+				ids := make([]uint, len(rows))
+				for i, row := range rows {
+					ids[i] = row.ID
+				}
+
+				got = append(got, ids)
+			}
+
+			if !slices.EqualFunc(test.expected, got, slices.Equal) {
+				t.Errorf("Pages() = %v, want %v", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	migrateTestStructs(t, db, 5)
+
+	t.Run("all rows", func(t *testing.T) {
+		t.Parallel()
+
+		var got []uint
+		for row, err := range All[*TestStruct](db, pagepagination.Must(0, 2, pagegeneric.Asc("id"))) {
+			if err != nil {
+				t.Fatalf("All() unexpected error: %v", err)
+			}
+
+			got = append(got, row.ID)
+		}
+
+		if expected := []uint{1, 2, 3, 4, 5}; !slices.Equal(expected, got) {
+			t.Errorf("All() = %v, want %v", got, expected)
+		}
+	})
+
+	t.Run("break stops the iteration", func(t *testing.T) {
+		t.Parallel()
+
+		var got []uint
+		for row, err := range All[TestStruct](db, cursorpagination.Must(2, cursorpagination.Asc("id", nil))) {
+			if err != nil {
+				t.Fatalf("All() unexpected error: %v", err)
+			}
+
+			got = append(got, row.ID)
+			if len(got) == 3 {
+				break
+			}
+		}
+
+		if expected := []uint{1, 2, 3}; !slices.Equal(expected, got) {
+			t.Errorf("All() = %v, want %v", got, expected)
+		}
+	})
+}
+
+func TestPagesErrors(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	migrateTestStructs(t, db, 5)
+
+	t.Run("canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		var pages int
+		var gotErr error
+		for _, err := range Pages[TestStruct](db.WithContext(ctx), pagepagination.Must(0, 2)) {
+			if err != nil {
+				gotErr = err
+
+				continue
+			}
+
+			pages++
+			cancel()
+		}
+
+		if pages != 1 || !errors.Is(gotErr, context.Canceled) {
+			t.Errorf("Pages() = (%d pages, %v), want (1 page, %v)", pages, gotErr, context.Canceled)
+		}
+	})
+
+	t.Run("nil pagination", func(t *testing.T) {
+		t.Parallel()
+
+		for _, err := range All[TestStruct](db, nil) {
+			if !errors.Is(err, ErrPaginationRequired) {
+				t.Errorf("All() = %v, want %v", err, ErrPaginationRequired)
+			}
+		}
+	})
+
+	t.Run("not iterable pagination", func(t *testing.T) {
+		t.Parallel()
+
+		for _, err := range Pages[TestStruct](db, notIterablePagination{Pagination: pagepagination.Must(0, 2)}) {
+			if !errors.Is(err, ErrPaginationNotIterable) {
+				t.Errorf("Pages() = %v, want %v", err, ErrPaginationNotIterable)
+			}
+		}
+	})
+}
+
+type notIterablePagination struct {
+	*pagepagination.Pagination
+}
+
+func migrateTestStructs(t *testing.T, db *gorm.DB, n int) {
+	t.Helper()
+
+	if n == 0 {
+		return
+	}
+
+	toMigrate := make([]*TestStruct, n)
+	for i := range n {
+		toMigrate[i] = &TestStruct{Code: strconv.Itoa(i + 1), Price: uint(i + 1)}
+	}
+
+	if txCreate := db.CreateInBatches(&toMigrate, n); txCreate.Error != nil {
+		t.Fatal(txCreate.Error)
+	}
+}
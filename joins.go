@@ -0,0 +1,125 @@
+package pagorminator
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// distinctAlias is the alias of the distinct primary keys subquery of the count.
+const distinctAlias = "pagorminator_distinct"
+
+// hasToManyJoins returns true if the statement joins a has-many or many-to-many relationship,
+// so the rows are multiplied by the joined rows.
+// Both the relationship joins, e.g. Joins("Items"), and the raw SQL joins of the relationship tables,
+// e.g. Joins("JOIN items ON items.order_id = orders.id"), are detected.
+func hasToManyJoins(stmt *gorm.Statement) bool {
+	if stmt.Schema == nil || len(stmt.Joins) == 0 {
+		return false
+	}
+
+	for _, join := range stmt.Joins {
+		if relations, ok := joinRelations(stmt.Schema, join.Name); ok {
+			for _, relation := range relations {
+				if isToMany(relation) {
+					return true
+				}
+			}
+
+			continue
+		}
+
+		for _, relation := range stmt.Schema.Relationships.Relations {
+			if isToMany(relation) && joinsTable(join.Name, relation) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// joinRelations returns the relationships of a join name, e.g. "Manager.Company",
+// or false if it is a raw SQL join.
+func joinRelations(modelSchema *schema.Schema, name string) ([]*schema.Relationship, bool) {
+	relationships := modelSchema.Relationships.Relations
+	names := strings.Split(name, ".")
+	relations := make([]*schema.Relationship, 0, len(names))
+
+	for _, relationName := range names {
+		relation, ok := relationships[relationName]
+		if !ok {
+			return nil, false
+		}
+
+		relations = append(relations, relation)
+		relationships = relation.FieldSchema.Relationships.Relations
+	}
+
+	return relations, true
+}
+
+func isToMany(relation *schema.Relationship) bool {
+	return relation.Type == schema.HasMany || relation.Type == schema.Many2Many
+}
+
+// joinsTable returns true if the raw SQL join references the relationship table, or its join table,
+// i.e. the table name is the word after a JOIN keyword, quoted or not.
+func joinsTable(sql string, relation *schema.Relationship) bool {
+	tables := []string{relation.FieldSchema.Table}
+	if relation.JoinTable != nil {
+		tables = append(tables, relation.JoinTable.Table)
+	}
+
+	words := strings.FieldsFunc(sql, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+
+	for i := 1; i < len(words); i++ {
+		if strings.EqualFold(words[i-1], "JOIN") && slices.ContainsFunc(tables, func(table string) bool {
+			return strings.EqualFold(words[i], table)
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deduplicateJoins selects the distinct rows of the statement.
+// The columns of the has-many and many-to-many relationship joins are not selected,
+// as they keep the joined rows distinct and gorm does not scan them.
+func deduplicateJoins(stmt *gorm.Statement) {
+	stmt.Distinct = true
+
+	// the joins are shared with the statement the query was chained from.
+	stmt.Joins = slices.Clone(stmt.Joins)
+	for i, join := range stmt.Joins {
+		if relations, ok := joinRelations(stmt.Schema, join.Name); ok && slices.ContainsFunc(relations, isToMany) {
+			stmt.Joins[i].Omits = append(slices.Clone(join.Omits), "*")
+		}
+	}
+}
+
+// distinctPrimaryKeys selects the distinct primary keys of the joined rows of tx, so the count does not count
+// the rows multiplied by the has-many or many-to-many joins.
+// A composite primary key is selected in a subquery and its rows are counted,
+// as not every dialect counts the distinct values of several columns.
+func distinctPrimaryKeys(db, tx *gorm.DB) *gorm.DB {
+	// the statement schema is always set with has-many or many-to-many joins.
+	modelSchema := db.Statement.Schema
+	if modelSchema.PrioritizedPrimaryField != nil {
+		return tx.Distinct(db.Statement.Table + "." + modelSchema.PrioritizedPrimaryField.DBName)
+	}
+
+	columns := make([]string, len(modelSchema.PrimaryFieldDBNames))
+	for i, name := range modelSchema.PrimaryFieldDBNames {
+		columns[i] = db.Statement.Table + "." + name
+	}
+
+	return tx.Session(&gorm.Session{NewDB: true, Context: db.Statement.Context}).
+		Table("(?) AS "+distinctAlias, tx.Set(countKey, true).Distinct(columns))
+}
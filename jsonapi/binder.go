@@ -0,0 +1,156 @@
+package jsonapi
+
+import (
+	"cmp"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/httpbind"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+const (
+	// NumberParam is the page number query parameter.
+	NumberParam = "page[number]"
+	// SizeParam is the page size query parameter.
+	SizeParam = "page[size]"
+	// CursorParam is the page cursor query parameter of the cursor pagination profile.
+	CursorParam = "page[cursor]"
+	// SortParam is the sort query parameter, e.g. `sort=-created,title`.
+	SortParam = "sort"
+	// DefaultSize is the default page size when the page size parameter is not present.
+	DefaultSize = 20
+)
+
+// Binder binds the JSON:API pagination query parameters.
+// The page numbers are one-based, as usual in JSON:API, so `page[number]=1` is the first page.
+// The errors are the httpbind.BindError, with the query parameter of each error, as JSON:API `source.parameter`.
+// The zero value uses DefaultSize and no maximum size.
+type Binder struct {
+	// DefaultSize is the size when the page size parameter is not present, DefaultSize if zero.
+	DefaultSize int
+	// MaxSize is the maximum size allowed, no maximum if zero.
+	MaxSize int
+	// AllowedSorts are the fields allowed in the sort parameter. If empty, any field name is allowed.
+	AllowedSorts []string
+	// DefaultSort is the sort when the sort parameter is not present.
+	DefaultSort pagegeneric.Sort
+	// Cursors is the cursors definition for cursor pagination, their values are taken from the page cursor parameter.
+	Cursors []cursorpagination.Cursor
+}
+
+// Page binds the page number, page size and sort parameters to a page pagination.
+//
+// Errors:
+//   - httpbind.BindError with the errors of each parameter.
+func (b Binder) Page(values url.Values) (*pagepagination.Pagination, error) {
+	var bindError httpbind.BindError
+
+	number := httpbind.BindInt(&bindError, values, NumberParam, 1)
+	if number < 1 {
+		bindError.AddParam(values, NumberParam, ErrNumberNotValid)
+	}
+
+	size := b.bindSize(&bindError, values)
+	sort := b.bindSort(&bindError, values)
+
+	if len(bindError.Errors) > 0 {
+		return nil, bindError
+	}
+
+	pagination, err := pagepagination.New(number-1, size, sort...)
+	if err != nil {
+		bindError.AddParam(values, NumberParam, err)
+
+		return nil, bindError
+	}
+
+	return pagination, nil
+}
+
+// PageFromRequest binds the page query parameters of the request to a page pagination.
+//
+// Errors:
+//   - httpbind.BindError with the errors of each parameter.
+func (b Binder) PageFromRequest(r *http.Request) (*pagepagination.Pagination, error) {
+	return b.Page(r.URL.Query())
+}
+
+// Cursor binds the page size and page cursor parameters to a cursor pagination using the Cursors definition.
+//
+// Errors:
+//   - httpbind.BindError with the errors of each parameter.
+func (b Binder) Cursor(values url.Values) (*cursorpagination.Pagination, error) {
+	var bindError httpbind.BindError
+
+	size := b.bindSize(&bindError, values)
+
+	// a size error doesn't hide the page cursor errors.
+	pagination, err := cursorpagination.FromToken(max(size, 1), values.Get(CursorParam), b.Cursors...)
+	if err != nil {
+		bindError.AddParam(values, CursorParam, err)
+	}
+
+	if len(bindError.Errors) > 0 {
+		return nil, bindError
+	}
+
+	return pagination, nil
+}
+
+// CursorFromRequest binds the cursor query parameters of the request to a cursor pagination.
+//
+// Errors:
+//   - httpbind.BindError with the errors of each parameter.
+func (b Binder) CursorFromRequest(r *http.Request) (*cursorpagination.Pagination, error) {
+	return b.Cursor(r.URL.Query())
+}
+
+func (b Binder) bindSize(bindError *httpbind.BindError, values url.Values) int {
+	size := httpbind.BindInt(bindError, values, SizeParam, b.defaultSize())
+
+	switch {
+	case size < 1:
+		bindError.AddParam(values, SizeParam, ErrSizeNotValid)
+	case b.MaxSize > 0 && size > b.MaxSize:
+		bindError.AddParam(values, SizeParam, httpbind.ErrSizeTooBig)
+	}
+
+	return size
+}
+
+func (b Binder) bindSort(bindError *httpbind.BindError, values url.Values) pagegeneric.Sort {
+	raw := values.Get(SortParam)
+	if raw == "" {
+		return b.DefaultSort
+	}
+
+	var sort pagegeneric.Sort
+
+	for field := range strings.SplitSeq(raw, ",") {
+		field = strings.TrimSpace(field)
+		descending := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+
+		switch {
+		case !httpbind.IsColumnName(field):
+			bindError.AddParam(values, SortParam, httpbind.ErrSortNotValid)
+		case len(b.AllowedSorts) > 0 && !slices.Contains(b.AllowedSorts, field):
+			bindError.AddParam(values, SortParam, httpbind.ErrSortNotAllowed)
+		case descending:
+			sort = append(sort, pagegeneric.Desc(field))
+		default:
+			sort = append(sort, pagegeneric.Asc(field))
+		}
+	}
+
+	return sort
+}
+
+func (b Binder) defaultSize() int {
+	return cmp.Or(b.DefaultSize, DefaultSize)
+}
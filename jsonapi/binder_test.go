@@ -0,0 +1,135 @@
+package jsonapi
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/httpbind"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+)
+
+func TestBinderPage(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		binder   Binder
+		query    string
+		wantPage int
+		wantSize int
+		wantSort pagegeneric.Sort
+		wantErrs map[string][]error
+	}{
+		"defaults": {
+			query:    "",
+			wantPage: 0,
+			wantSize: DefaultSize,
+		},
+		"number, size and sort": {
+			query:    "page[number]=3&page[size]=5&sort=-price,id",
+			wantPage: 2,
+			wantSize: 5,
+			wantSort: pagegeneric.Sort{pagegeneric.Desc("price"), pagegeneric.Asc("id")},
+		},
+		"default sort": {
+			binder:   Binder{DefaultSort: pagegeneric.Sort{pagegeneric.Asc("id")}},
+			query:    "page%5Bnumber%5D=1",
+			wantPage: 0,
+			wantSize: DefaultSize,
+			wantSort: pagegeneric.Sort{pagegeneric.Asc("id")},
+		},
+		"errors aggregated per parameter": {
+			binder: Binder{MaxSize: 100, AllowedSorts: []string{"price"}},
+			query:  "page[number]=0&page[size]=101&sort=-id,price%20drop",
+			wantErrs: map[string][]error{
+				NumberParam: {ErrNumberNotValid},
+				SizeParam:   {httpbind.ErrSizeTooBig},
+				SortParam:   {httpbind.ErrSortNotAllowed, httpbind.ErrSortNotValid},
+			},
+		},
+		"not a number": {
+			query: "page[number]=one&page[size]=0",
+			wantErrs: map[string][]error{
+				NumberParam: {httpbind.ErrNotANumber},
+				SizeParam:   {ErrSizeNotValid},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := url.ParseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := test.binder.Page(values)
+			if test.wantErrs != nil {
+				var bindError httpbind.BindError
+				if !errors.As(err, &bindError) {
+					t.Fatalf("Page() error = %v, want BindError", err)
+				}
+
+				if diff := cmp.Diff(test.wantErrs, bindError.ByParam(), cmpopts.EquateErrors()); diff != "" {
+					t.Errorf("Page() errors diff (-want +got):\n%s", diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Page() unexpected error: %v", err)
+			}
+
+			if got.Page() != test.wantPage || got.Size() != test.wantSize {
+				t.Errorf("Page() = (page %d, size %d), want (page %d, size %d)",
+					got.Page(), got.Size(), test.wantPage, test.wantSize)
+			}
+
+			if diff := cmp.Diff(test.wantSort, got.Sort()); diff != "" {
+				t.Errorf("Page() sort diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBinderCursor(t *testing.T) {
+	t.Parallel()
+
+	binder := Binder{Cursors: []cursorpagination.Cursor{cursorpagination.Asc("id", nil)}}
+
+	token, err := cursorpagination.Must(2, cursorpagination.Asc("id", 4)).Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := binder.Cursor(url.Values{SizeParam: {"2"}, CursorParam: {token}})
+	if err != nil {
+		t.Fatalf("Cursor() unexpected error: %v", err)
+	}
+
+	if got.Size() != 2 || got.Cursors()[0].Value() != int64(4) {
+		t.Errorf("Cursor() = (size %d, cursors %v), want (size 2, id 4)", got.Size(), got.Cursors())
+	}
+
+	_, err = binder.Cursor(url.Values{SizeParam: {"-1"}, CursorParam: {"not a token"}})
+
+	var bindError httpbind.BindError
+	if !errors.As(err, &bindError) {
+		t.Fatalf("Cursor() error = %v, want BindError", err)
+	}
+
+	wantErrs := map[string][]error{
+		SizeParam:   {ErrSizeNotValid},
+		CursorParam: {cursorpagination.ErrTokenNotValid},
+	}
+	if diff := cmp.Diff(wantErrs, bindError.ByParam(), cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("Cursor() errors diff (-want +got):\n%s", diff)
+	}
+}
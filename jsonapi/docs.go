@@ -0,0 +1,5 @@
+// Package jsonapi binds the JSON:API pagination query parameters, https://jsonapi.org/format/#fetching-pagination,
+// `page[number]` and `page[size]` to page-based pagination, and `page[cursor]` and `page[size]`,
+// as in the cursor pagination profile, to cursor-based pagination.
+// It also creates the pagination `links` and `meta` objects of the response document.
+package jsonapi
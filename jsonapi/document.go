@@ -0,0 +1,106 @@
+package jsonapi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/httpbind"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+type (
+	// Links are the pagination links of the top-level `links` object.
+	// The links that are not available are null, as JSON:API requires.
+	Links struct {
+		First *string `json:"first"`
+		Prev  *string `json:"prev"`
+		Next  *string `json:"next"`
+		Last  *string `json:"last"`
+	}
+
+	// Meta is the pagination information of the top-level `meta` object.
+	Meta struct {
+		// Total is the total number of resources, omitted if the total elements are not set.
+		Total *int64 `json:"total,omitempty"`
+	}
+)
+
+// NewLinks returns the pagination links of a pagination that has run.
+// The other query parameters of the base URL are preserved.
+// The last link is null if the total elements are not set, and unpaged paginations have no links.
+// Cursor paginations only have the first and next links.
+//
+// Errors:
+//   - Any error encoding the cursor tokens.
+func NewLinks(base *url.URL, pagination pagorminator.Pagination) (Links, error) {
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination, *idpagination.Pagination:
+		return pageLinks(base, typed), nil
+	case *cursorpagination.Pagination:
+		return cursorLinks(base, typed)
+	default:
+		return Links{}, nil
+	}
+}
+
+// NewMeta returns the pagination meta of a pagination that has run.
+func NewMeta(pagination pagorminator.Pagination) Meta {
+	totalElements, ok := pagination.TotalElements()
+	if !ok {
+		return Meta{Total: nil}
+	}
+
+	return Meta{Total: &totalElements}
+}
+
+// pageLinks returns the page links, with the one-based page numbers.
+func pageLinks(base *url.URL, pagination pagorminator.Pagination) Links {
+	var links Links
+
+	params := httpbind.PageLinkParams{Page: NumberParam, Size: SizeParam, FirstPage: 1}
+	for _, link := range httpbind.PageLinks(base, pagination, params) {
+		switch link.Rel {
+		case "first":
+			links.First = &link.URL
+		case "prev":
+			links.Prev = &link.URL
+		case "next":
+			links.Next = &link.URL
+		case "last":
+			links.Last = &link.URL
+		}
+	}
+
+	return links
+}
+
+// cursorLinks returns the cursor links, none if the pagination is unpaged, since the binder rejects a zero size.
+func cursorLinks(base *url.URL, pagination *cursorpagination.Pagination) (Links, error) {
+	if pagination.Size() == 0 {
+		return Links{}, nil
+	}
+
+	size := strconv.Itoa(pagination.Size())
+	// the page number is removed, in case the base URL is from a page pagination request.
+	first := httpbind.WithQuery(base, map[string]string{SizeParam: size, CursorParam: "", NumberParam: ""})
+	links := Links{First: &first, Prev: nil, Next: nil, Last: nil}
+
+	next, hasNext := pagination.Next()
+	if !hasNext {
+		return links, nil
+	}
+
+	token, err := next.Token()
+	if err != nil {
+		return Links{}, fmt.Errorf("encoding next cursor: %w", err)
+	}
+
+	nextURL := httpbind.WithQuery(base, map[string]string{SizeParam: size, CursorParam: token, NumberParam: ""})
+	links.Next = &nextURL
+
+	return links, nil
+}
@@ -0,0 +1,126 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestNewLinksAndMeta(t *testing.T) {
+	t.Parallel()
+
+	base, err := url.Parse("https://api.example.com/articles?filter[author]=1&page[number]=2&page[size]=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nextToken, err := cursorpagination.Must(2, cursorpagination.Asc("id", 4)).Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		pagination func() pagorminator.Pagination
+		wantJSON   string
+	}{
+		"middle page": {
+			pagination: func() pagorminator.Pagination {
+				p := pagepagination.Must(1, 2)
+				_ = p.SetTotalElements(5)
+
+				return p
+			},
+			wantJSON: `{"links":{` +
+				`"first":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=1&page%5Bsize%5D=2",` +
+				`"prev":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=1&page%5Bsize%5D=2",` +
+				`"next":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=3&page%5Bsize%5D=2",` +
+				`"last":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=3&page%5Bsize%5D=2"},` +
+				`"meta":{"total":5}}`,
+		},
+		"offset not multiple of the size": {
+			pagination: func() pagorminator.Pagination {
+				p, _ := pagepagination.NewOffset(5, 10)
+				_ = p.SetTotalElements(30)
+
+				return p
+			},
+			wantJSON: `{"links":{` +
+				`"first":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=1&page%5Bsize%5D=10",` +
+				`"prev":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=1&page%5Bsize%5D=10",` +
+				`"next":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=2&page%5Bsize%5D=10",` +
+				`"last":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=3&page%5Bsize%5D=10"},` +
+				`"meta":{"total":30}}`,
+		},
+		"count skipped": {
+			pagination: func() pagorminator.Pagination { return pagepagination.Must(1, 2) },
+			wantJSON: `{"links":{` +
+				`"first":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=1&page%5Bsize%5D=2",` +
+				`"prev":null,"next":null,"last":null},` +
+				`"meta":{}}`,
+		},
+		"single page": {
+			pagination: func() pagorminator.Pagination {
+				p := pagepagination.Must(0, 2)
+				_ = p.SetTotalElements(0)
+
+				return p
+			},
+			wantJSON: `{"links":{` +
+				`"first":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=1&page%5Bsize%5D=2",` +
+				`"prev":null,"next":null,` +
+				`"last":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bnumber%5D=1&page%5Bsize%5D=2"},` +
+				`"meta":{"total":0}}`,
+		},
+		"cursor with next page": {
+			pagination: func() pagorminator.Pagination {
+				p := cursorpagination.Must(2, cursorpagination.Asc("id", nil))
+				p.SetLatestQueryValues(2, map[string]any{"id": 4})
+
+				return p
+			},
+			wantJSON: `{"links":{` +
+				`"first":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bsize%5D=2",` +
+				`"prev":null,` +
+				`"next":"https://api.example.com/articles?filter%5Bauthor%5D=1&page%5Bcursor%5D=` + nextToken +
+				`&page%5Bsize%5D=2",` +
+				`"last":null},` +
+				`"meta":{}}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pagination := test.pagination()
+
+			links, err := NewLinks(base, pagination)
+			if err != nil {
+				t.Fatalf("NewLinks() unexpected error: %v", err)
+			}
+
+			var got strings.Builder
+
+			encoder := json.NewEncoder(&got)
+			encoder.SetEscapeHTML(false)
+
+			if err = encoder.Encode(struct {
+				Links Links `json:"links"`
+				Meta  Meta  `json:"meta"`
+			}{Links: links, Meta: NewMeta(pagination)}); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.wantJSON+"\n", got.String()); diff != "" {
+				t.Errorf("links and meta JSON diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
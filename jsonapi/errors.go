@@ -0,0 +1,10 @@
+package jsonapi
+
+import "errors"
+
+var (
+	// ErrNumberNotValid is returned when the page number is below one.
+	ErrNumberNotValid = errors.New("page number must be positive")
+	// ErrSizeNotValid is returned when the page size is below one.
+	ErrSizeNotValid = errors.New("page size must be positive")
+)
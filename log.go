@@ -0,0 +1,105 @@
+package pagorminator
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+const (
+	// CountStatusCounted is the status of the paginated queries whose total elements were counted.
+	CountStatusCounted = CountStatus("counted")
+	// CountStatusSkipped is the status of the paginated queries whose total elements were already set.
+	CountStatusSkipped = CountStatus("skipped")
+)
+
+type (
+	// CountStatus is whether the total elements of a paginated query were counted.
+	CountStatus string
+
+	logAttrsKey struct{}
+	logLevelKey struct{}
+)
+
+// WithLogAttrs returns a context whose paginated queries records contain the attributes, e.g. the request id.
+func WithLogAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	previous, _ := ctx.Value(logAttrsKey{}).([]slog.Attr)
+
+	return context.WithValue(ctx, logAttrsKey{}, append(previous[:len(previous):len(previous)], attrs...))
+}
+
+// WithLogLevel returns a context whose paginated queries records are logged with the level,
+// instead of slog.LevelDebug. The records of the failed counts are always logged with slog.LevelError.
+func WithLogLevel(ctx context.Context, level slog.Level) context.Context {
+	return context.WithValue(ctx, logLevelKey{}, level)
+}
+
+// logPage logs the record of a paginated query.
+func (p PaGorminator) logPage(
+	db *gorm.DB,
+	pagination Pagination,
+	status CountStatus,
+	countDuration time.Duration,
+	err error,
+) {
+	if p.Logger == nil {
+		return
+	}
+
+	ctx := db.Statement.Context
+
+	level := slog.LevelDebug
+	if contextLevel, ok := ctx.Value(logLevelKey{}).(slog.Level); ok {
+		level = contextLevel
+	}
+
+	if err != nil {
+		level = slog.LevelError
+	}
+
+	if !p.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("type", string(paginationTypeOf(pagination))),
+		slog.String("table", db.Statement.Table),
+		slog.Int("size", pagination.Size()),
+	}
+
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination:
+		attrs = append(attrs, slog.Int("page", typed.Page()))
+	case *idpagination.Pagination:
+		attrs = append(attrs, slog.Int("page", typed.Page()))
+	case *cursorpagination.Pagination:
+		attrs = append(attrs,
+			slog.Any("cursor_columns", getCursorColumns(typed.Cursors())),
+			slog.Bool("cursor_values", typed.HasCursorValues()))
+	}
+
+	if totalElements, ok := pagination.TotalElements(); ok {
+		attrs = append(attrs, slog.Int64("total", totalElements))
+	}
+
+	attrs = append(attrs, slog.String("count", string(status)))
+	if status == CountStatusCounted {
+		attrs = append(attrs, slog.Duration("count_duration", countDuration))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	if contextAttrs, ok := ctx.Value(logAttrsKey{}).([]slog.Attr); ok {
+		attrs = append(attrs, contextAttrs...)
+	}
+
+	p.Logger.LogAttrs(ctx, level, "pagorminator: paginated query", attrs...)
+}
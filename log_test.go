@@ -0,0 +1,156 @@
+package pagorminator
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pagination func() Pagination
+		where      string
+		want       map[string]any
+	}{
+		"page pagination counted": {
+			pagination: func() Pagination {
+				return pagepagination.Must(1, 2)
+			},
+			want: map[string]any{
+				"level": "DEBUG",
+				"type":  "page",
+				"table": "test_structs",
+				"size":  float64(2),
+				"page":  float64(1),
+				"total": float64(5),
+				"count": "counted",
+			},
+		},
+		"page pagination skipped": {
+			pagination: func() Pagination {
+				p := pagepagination.Must(0, 2)
+				_ = p.SetTotalElements(10)
+
+				return p
+			},
+			want: map[string]any{
+				"level": "DEBUG",
+				"type":  "page",
+				"table": "test_structs",
+				"size":  float64(2),
+				"page":  float64(0),
+				"total": float64(10),
+				"count": "skipped",
+			},
+		},
+		"cursor pagination": {
+			pagination: func() Pagination {
+				return cursorpagination.Must(2, cursorpagination.Asc("id", 2))
+			},
+			want: map[string]any{
+				"level":          "DEBUG",
+				"type":           "cursor",
+				"table":          "test_structs",
+				"size":           float64(2),
+				"cursor_columns": []any{"id"},
+				"cursor_values":  true,
+				"total":          float64(5),
+				"count":          "counted",
+			},
+		},
+		"count error": {
+			pagination: func() Pagination {
+				return pagepagination.Must(0, 2)
+			},
+			where: "missing = 1",
+			want: map[string]any{
+				"level": "ERROR",
+				"type":  "page",
+				"table": "test_structs",
+				"size":  float64(2),
+				"page":  float64(0),
+				"count": "counted",
+				"error": "no such column: missing",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			db := setupLoggedDB(t, slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+			tx := db
+			if test.where != "" {
+				tx = db.Where(test.where)
+			}
+
+			var products []*TestStruct
+			_ = tx.Clauses(test.pagination()).Find(&products)
+
+			got := decodeRecord(t, buf.Bytes())
+			delete(got, "time")
+			delete(got, "msg")
+			delete(got, "count_duration")
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("log record diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoggerContext(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	db := setupLoggedDB(t, slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	var products []*TestStruct
+	_ = db.Clauses(pagepagination.Must(0, 2)).Find(&products)
+
+	if buf.Len() != 0 {
+		t.Errorf("debug record logged with info level: %s", buf.String())
+	}
+
+	ctx := WithLogLevel(WithLogAttrs(t.Context(), slog.String("request_id", "abc")), slog.LevelInfo)
+	_ = db.WithContext(ctx).Clauses(pagepagination.Must(0, 2)).Find(&products)
+
+	got := decodeRecord(t, buf.Bytes())
+	if got["level"] != "INFO" || got["request_id"] != "abc" {
+		t.Errorf("log record = %v, want info level with request_id", got)
+	}
+}
+
+func decodeRecord(t *testing.T, raw []byte) map[string]any {
+	t.Helper()
+
+	var record map[string]any
+	if err := json.Unmarshal(raw, &record); err != nil {
+		t.Fatalf("decoding log record %q: %v", raw, err)
+	}
+
+	return record
+}
+
+func setupLoggedDB(t *testing.T, logger *slog.Logger) *gorm.DB {
+	t.Helper()
+
+	db := setupDBWithPlugin(t, PaGorminator{Logger: logger})
+	migrateTestStructs(t, db, 5)
+
+	return db
+}
@@ -5,6 +5,7 @@ import (
 	"gorm.io/gorm/clause"
 
 	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
 	"github.com/manuelarte/pagorminator/pagegeneric"
 	"github.com/manuelarte/pagorminator/pagepagination"
 )
@@ -15,6 +16,9 @@ var (
 	_ Pagination                           = new(pagepagination.Pagination)
 	_ Nexter[*pagepagination.Pagination]   = new(pagepagination.Pagination)
 	_ Prever[*pagepagination.Pagination]   = new(pagepagination.Pagination)
+	_ Pagination                           = new(idpagination.Pagination)
+	_ Nexter[*idpagination.Pagination]     = new(idpagination.Pagination)
+	_ Prever[*idpagination.Pagination]     = new(idpagination.Pagination)
 )
 
 type (
@@ -59,6 +63,14 @@ type (
 		IsTotalElementsSet() bool
 	}
 
+	// DefaultSorter is the interface that models implement to define a default sort.
+	// The plugin appends the default sort to the pagination sort, dropping the columns already sorted,
+	// so the results have a deterministic order.
+	DefaultSorter interface {
+		// DefaultSort returns the sort appended to the pagination sort.
+		DefaultSort() pagegeneric.Sort
+	}
+
 	// Pagination is the interface that combines the pagination request and the pagination count response.
 	Pagination interface {
 		PaginationRequest
@@ -0,0 +1,188 @@
+package pagorminator
+
+import (
+	"context"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+const (
+	// PaginationTypePage is the type of the page pagination.
+	PaginationTypePage = PaginationType("page")
+	// PaginationTypeCursor is the type of the cursor pagination.
+	PaginationTypeCursor = PaginationType("cursor")
+	// PaginationTypeID is the type of the ID pagination.
+	PaginationTypeID = PaginationType("id")
+	// PaginationTypeUnknown is the type of the paginations not defined in this module.
+	PaginationTypeUnknown = PaginationType("unknown")
+)
+
+var (
+	_ Event    = CountStartedEvent{}
+	_ Event    = CountFinishedEvent{}
+	_ Event    = PageAppliedEvent{}
+	_ Event    = CursorCapturedEvent{}
+	_ Observer = ObserverFunc(nil)
+	_ Observer = new(ExpvarObserver)
+)
+
+type (
+	// PaginationType is the type of pagination of a query.
+	PaginationType string
+
+	// Observer receives the events of the paginated queries, e.g. to publish metrics.
+	// The events are sent synchronously from the gorm callbacks, so the observer must not block.
+	Observer interface {
+		// Observe receives an event, one of CountStartedEvent, CountFinishedEvent, PageAppliedEvent
+		// or CursorCapturedEvent.
+		Observe(ctx context.Context, event Event)
+	}
+
+	// ObserverFunc is an Observer function.
+	ObserverFunc func(ctx context.Context, event Event)
+
+	// Event is an event of a paginated query.
+	Event interface {
+		event()
+	}
+
+	// CountStartedEvent is sent before counting the total elements.
+	CountStartedEvent struct {
+		Type  PaginationType
+		Table string
+	}
+
+	// CountFinishedEvent is sent after counting the total elements.
+	CountFinishedEvent struct {
+		Type     PaginationType
+		Table    string
+		Duration time.Duration
+		// SQL is the count query, with the vars as placeholders.
+		SQL           string
+		Err           error
+		TotalElements int64
+	}
+
+	// PageAppliedEvent is sent before running a paginated query.
+	PageAppliedEvent struct {
+		Type  PaginationType
+		Table string
+		Size  int
+		// Offset is the offset of the page, for page and ID pagination.
+		Offset int
+		// CursorDepth is the number of cursor columns in the keyset predicate, for cursor pagination.
+		// It is zero for the first page.
+		CursorDepth int
+	}
+
+	// CursorCapturedEvent is sent after a cursor paginated query, with the cursor values of the latest row.
+	CursorCapturedEvent struct {
+		Table  string
+		Rows   int
+		Values map[string]any
+	}
+)
+
+func (CountStartedEvent) event()   {}
+func (CountFinishedEvent) event()  {}
+func (PageAppliedEvent) event()    {}
+func (CursorCapturedEvent) event() {}
+
+// Observe calls the function.
+func (f ObserverFunc) Observe(ctx context.Context, event Event) {
+	f(ctx, event)
+}
+
+// ExpvarObserver publishes the events as counters in an expvar map:
+//   - counts_started, counts_finished and count_errors.
+//   - count_duration_seconds, the accumulated duration of the counts.
+//   - pages_applied and cursors_captured.
+//   - page_offset_max, the deepest offset requested.
+//
+//go:structinit
+type ExpvarObserver struct {
+	vars *expvar.Map
+
+	mu        sync.Mutex
+	maxOffset expvar.Int
+}
+
+// NewExpvarObserver Create an observer publishing the counters in the map, e.g. expvar.NewMap("pagorminator").
+func NewExpvarObserver(vars *expvar.Map) *ExpvarObserver {
+	observer := &ExpvarObserver{vars: vars}
+	vars.Set("page_offset_max", &observer.maxOffset)
+
+	return observer
+}
+
+// Observe adds the event to the counters.
+func (o *ExpvarObserver) Observe(_ context.Context, event Event) {
+	switch typed := event.(type) {
+	case CountStartedEvent:
+		o.vars.Add("counts_started", 1)
+	case CountFinishedEvent:
+		o.vars.Add("counts_finished", 1)
+		o.vars.AddFloat("count_duration_seconds", typed.Duration.Seconds())
+
+		if typed.Err != nil {
+			o.vars.Add("count_errors", 1)
+		}
+	case PageAppliedEvent:
+		o.vars.Add("pages_applied", 1)
+
+		o.mu.Lock()
+		if int64(typed.Offset) > o.maxOffset.Value() {
+			o.maxOffset.Set(int64(typed.Offset))
+		}
+		o.mu.Unlock()
+	case CursorCapturedEvent:
+		o.vars.Add("cursors_captured", 1)
+	}
+}
+
+func (p PaGorminator) observe(ctx context.Context, event Event) {
+	if p.Observer != nil {
+		p.Observer.Observe(ctx, event)
+	}
+}
+
+func paginationTypeOf(pagination Pagination) PaginationType {
+	switch pagination.(type) {
+	case *pagepagination.Pagination:
+		return PaginationTypePage
+	case *cursorpagination.Pagination:
+		return PaginationTypeCursor
+	case *idpagination.Pagination:
+		return PaginationTypeID
+	default:
+		return PaginationTypeUnknown
+	}
+}
+
+func pageAppliedEvent(table string, pagination Pagination) PageAppliedEvent {
+	event := PageAppliedEvent{
+		Type:        paginationTypeOf(pagination),
+		Table:       table,
+		Size:        pagination.Size(),
+		Offset:      0,
+		CursorDepth: 0,
+	}
+
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination:
+		event.Offset = typed.Offset()
+	case *idpagination.Pagination:
+		event.Offset = typed.Offset()
+	case *cursorpagination.Pagination:
+		if typed.HasCursorValues() {
+			event.CursorDepth = len(typed.Cursors())
+		}
+	}
+
+	return event
+}
@@ -0,0 +1,154 @@
+package pagorminator
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestObserver(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		events []Event
+	)
+
+	db := setupObservedDB(t, ObserverFunc(func(_ context.Context, event Event) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		events = append(events, event)
+	}))
+
+	tests := map[string]struct {
+		pagination Pagination
+		want       []Event
+	}{
+		"page pagination": {
+			pagination: pagepagination.Must(1, 2),
+			want: []Event{
+				PageAppliedEvent{Type: PaginationTypePage, Table: "test_structs", Size: 2, Offset: 2, CursorDepth: 0},
+				CountStartedEvent{Type: PaginationTypePage, Table: "test_structs"},
+				CountFinishedEvent{Type: PaginationTypePage, Table: "test_structs", TotalElements: 5},
+			},
+		},
+		"cursor pagination": {
+			pagination: cursorpagination.Must(2, cursorpagination.Asc("id", 1)),
+			want: []Event{
+				PageAppliedEvent{Type: PaginationTypeCursor, Table: "test_structs", Size: 2, Offset: 0, CursorDepth: 1},
+				CountStartedEvent{Type: PaginationTypeCursor, Table: "test_structs"},
+				CountFinishedEvent{Type: PaginationTypeCursor, Table: "test_structs", TotalElements: 5},
+				CursorCapturedEvent{Table: "test_structs", Rows: 2, Values: map[string]any{"id": uint(3)}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mu.Lock()
+			events = nil
+			mu.Unlock()
+
+			var products []*TestStruct
+			if err := db.Clauses(test.pagination).Find(&products).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			opts := cmp.Options{cmpopts.IgnoreFields(CountFinishedEvent{}, "Duration", "SQL")}
+			if diff := cmp.Diff(test.want, events, opts); diff != "" {
+				t.Errorf("events diff (-want +got):\n%s", diff)
+			}
+
+			for _, event := range events {
+				if finished, ok := event.(CountFinishedEvent); ok && !strings.Contains(finished.SQL, "count(*)") {
+					t.Errorf("count finished SQL = %q, want count query", finished.SQL)
+				}
+			}
+		})
+	}
+}
+
+func TestObserverCountError(t *testing.T) {
+	t.Parallel()
+
+	var finished []CountFinishedEvent
+
+	db := setupObservedDB(t, ObserverFunc(func(_ context.Context, event Event) {
+		if typed, ok := event.(CountFinishedEvent); ok {
+			finished = append(finished, typed)
+		}
+	}))
+
+	var products []*TestStruct
+
+	err := db.Clauses(pagepagination.Must(0, 2)).Where("missing = ?", 1).Find(&products).Error
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(finished) != 1 || finished[0].Err == nil {
+		t.Errorf("count finished events = %+v, want one with error", finished)
+	}
+}
+
+func TestExpvarObserver(t *testing.T) {
+	t.Parallel()
+
+	vars := new(expvar.Map).Init()
+	observer := NewExpvarObserver(vars)
+	ctx := t.Context()
+
+	observer.Observe(ctx, PageAppliedEvent{Type: PaginationTypePage, Table: "products", Size: 10, Offset: 100})
+	observer.Observe(ctx, PageAppliedEvent{Type: PaginationTypePage, Table: "products", Size: 10, Offset: 20})
+	observer.Observe(ctx, CountStartedEvent{Type: PaginationTypePage, Table: "products"})
+	observer.Observe(ctx, CountFinishedEvent{
+		Type:     PaginationTypePage,
+		Table:    "products",
+		Duration: 2 * time.Second,
+		Err:      errors.New("timeout"),
+	})
+	observer.Observe(ctx, CursorCapturedEvent{Table: "products", Rows: 10, Values: map[string]any{"id": 1}})
+
+	want := map[string]string{
+		"count_duration_seconds": "2",
+		"count_errors":           "1",
+		"counts_finished":        "1",
+		"counts_started":         "1",
+		"cursors_captured":       "1",
+		"page_offset_max":        "100",
+		"pages_applied":          "2",
+	}
+
+	got := make(map[string]string)
+	vars.Do(func(kv expvar.KeyValue) {
+		got[kv.Key] = kv.Value.String()
+	})
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("expvar diff (-want +got):\n%s", diff)
+	}
+}
+
+func setupObservedDB(t *testing.T, observer Observer) *gorm.DB {
+	t.Helper()
+
+	db := setupDBWithPlugin(t, PaGorminator{Observer: observer})
+	migrateTestStructs(t, db, 5)
+
+	return db
+}
@@ -0,0 +1,141 @@
+package odata
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/manuelarte/pagorminator/httpbind"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+const (
+	// TopParam is the $top query option.
+	TopParam = "$top"
+	// SkipParam is the $skip query option.
+	SkipParam = "$skip"
+	// OrderByParam is the $orderby query option, e.g. `$orderby=Name desc,Price`.
+	OrderByParam = "$orderby"
+	// CountParam is the $count query option.
+	CountParam = "$count"
+	// DefaultTop is the default page size when $top is not present.
+	DefaultTop = 20
+)
+
+type (
+	// Binder binds the OData query options.
+	// The zero value uses DefaultTop and no maximum $top.
+	Binder struct {
+		// DefaultTop is the page size when $top is not present, DefaultTop if zero.
+		DefaultTop int
+		// MaxTop is the maximum $top allowed, no maximum if zero.
+		MaxTop int
+		// DefaultOrderBy is the sort when $orderby is not present.
+		DefaultOrderBy pagegeneric.Sort
+	}
+
+	// Request is the bound OData request.
+	Request struct {
+		// Pagination is the page pagination with the $orderby sort.
+		Pagination *pagepagination.Pagination
+		// Count is true if the response must include the @odata.count annotation.
+		Count bool
+	}
+)
+
+// Bind binds the query options to a page pagination, validating the $orderby properties against the model schema.
+// The $orderby properties are the Go field names of the model, sorted by their columns.
+//
+// Errors:
+//   - httpbind.BindError with the errors of each query option.
+//   - Any error parsing the model schema.
+func (b Binder) Bind(db *gorm.DB, model any, values url.Values) (Request, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return Request{}, fmt.Errorf("parsing model schema: %w", err)
+	}
+
+	var bindError httpbind.BindError
+
+	top := httpbind.BindInt(&bindError, values, TopParam, b.defaultTop())
+	switch {
+	case top < 1:
+		bindError.AddParam(values, TopParam, ErrTopNotValid)
+	case b.MaxTop > 0 && top > b.MaxTop:
+		bindError.AddParam(values, TopParam, httpbind.ErrSizeTooBig)
+	}
+
+	skip := httpbind.BindInt(&bindError, values, SkipParam, 0)
+	if skip < 0 {
+		bindError.AddParam(values, SkipParam, ErrSkipCantBeNegative)
+	}
+
+	orderBy := b.bindOrderBy(&bindError, values, stmt.Schema)
+	count := bindCount(&bindError, values)
+
+	if len(bindError.Errors) > 0 {
+		return Request{}, bindError
+	}
+
+	pagination, err := pagepagination.NewOffset(skip, top, orderBy...)
+	if err != nil {
+		bindError.AddParam(values, SkipParam, err)
+
+		return Request{}, bindError
+	}
+
+	return Request{Pagination: pagination, Count: count}, nil
+}
+
+func (b Binder) bindOrderBy(bindError *httpbind.BindError, values url.Values, modelSchema *schema.Schema) pagegeneric.Sort {
+	raw := values.Get(OrderByParam)
+	if raw == "" {
+		return b.DefaultOrderBy
+	}
+
+	var sort pagegeneric.Sort
+
+	for item := range strings.SplitSeq(raw, ",") {
+		property, direction, _ := strings.Cut(strings.TrimSpace(item), " ")
+
+		field, ok := modelSchema.FieldsByName[property]
+		if !ok || field.DBName == "" {
+			bindError.AddParam(values, OrderByParam, fmt.Errorf("%w: %q", ErrPropertyNotFound, property))
+
+			continue
+		}
+
+		switch strings.TrimSpace(direction) {
+		case "", "asc":
+			sort = append(sort, pagegeneric.AscField(field.Name))
+		case "desc":
+			sort = append(sort, pagegeneric.DescField(field.Name))
+		default:
+			bindError.AddParam(values, OrderByParam, httpbind.ErrSortNotValid)
+		}
+	}
+
+	return sort
+}
+
+func (b Binder) defaultTop() int {
+	return cmp.Or(b.DefaultTop, DefaultTop)
+}
+
+func bindCount(bindError *httpbind.BindError, values url.Values) bool {
+	switch values.Get(CountParam) {
+	case "", "false":
+		return false
+	case "true":
+		return true
+	default:
+		bindError.AddParam(values, CountParam, ErrCountNotValid)
+
+		return false
+	}
+}
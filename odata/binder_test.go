@@ -0,0 +1,195 @@
+package odata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/httpbind"
+)
+
+type testProduct struct {
+	ID    uint `gorm:"primarykey"`
+	Name  string
+	Price uint `gorm:"column:unit_price"`
+}
+
+func TestBinderBind(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	base, err := url.Parse("https://api.example.com/Products?$orderby=Price%20desc,Name&$top=2&$count=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request, err := Binder{}.Bind(db, &testProduct{}, base.Query())
+	if err != nil {
+		t.Fatalf("Bind() unexpected error: %v", err)
+	}
+
+	var products []*testProduct
+	if err = db.Clauses(request.Pagination).Find(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	var got strings.Builder
+
+	encoder := json.NewEncoder(&got)
+	encoder.SetEscapeHTML(false)
+
+	if err = encoder.Encode(NewCollection(base, request, products)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"@odata.count":5,` +
+		`"@odata.nextLink":"https://api.example.com/Products?%24count=true&%24orderby=Price+desc%2CName&%24skip=2&%24top=2",` +
+		`"value":[{"ID":3,"Name":"C","Price":20},{"ID":4,"Name":"D","Price":20}]}` + "\n"
+	if diff := cmp.Diff(want, got.String()); diff != "" {
+		t.Errorf("NewCollection() JSON diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestBinderBindLastPage(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	values := url.Values{TopParam: {"2"}, SkipParam: {"4"}}
+
+	request, err := Binder{}.Bind(db, &testProduct{}, values)
+	if err != nil {
+		t.Fatalf("Bind() unexpected error: %v", err)
+	}
+
+	var products []*testProduct
+	if err = db.Clauses(request.Pagination).Find(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewCollection(&url.URL{Path: "/Products", RawQuery: values.Encode()}, request, products)
+	if got.Count != nil || got.NextLink != nil || len(got.Value) != 1 {
+		t.Errorf("NewCollection() = %+v, want one row without count nor next link", got)
+	}
+}
+
+func TestBinderBindSkipNotMultipleOfTop(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	values := url.Values{TopParam: {"2"}, SkipParam: {"1"}, OrderByParam: {"ID"}}
+
+	request, err := Binder{}.Bind(db, &testProduct{}, values)
+	if err != nil {
+		t.Fatalf("Bind() unexpected error: %v", err)
+	}
+
+	var products []*testProduct
+	if err = db.Clauses(request.Pagination).Find(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewCollection(&url.URL{Path: "/Products", RawQuery: values.Encode()}, request, products)
+	if len(got.Value) != 2 || got.Value[0].ID != 2 || got.Value[1].ID != 3 {
+		t.Errorf("NewCollection() value = %+v, want the products 2 and 3", got.Value)
+	}
+
+	if got.NextLink == nil || !strings.Contains(*got.NextLink, "%24skip=3") {
+		t.Errorf("NewCollection() next link = %v, want $skip=3", got.NextLink)
+	}
+}
+
+func TestBinderBindErrors(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+
+	tests := map[string]struct {
+		binder   Binder
+		query    string
+		wantErrs map[string][]error
+	}{
+		"top and skip not valid": {
+			binder: Binder{MaxTop: 10},
+			query:  "$top=11&$skip=-1",
+			wantErrs: map[string][]error{
+				TopParam:  {httpbind.ErrSizeTooBig},
+				SkipParam: {ErrSkipCantBeNegative},
+			},
+		},
+		"not numbers and zero top": {
+			query: "$top=0&$skip=two",
+			wantErrs: map[string][]error{
+				TopParam:  {ErrTopNotValid},
+				SkipParam: {httpbind.ErrNotANumber},
+			},
+		},
+		"orderby and count not valid": {
+			query: "$orderby=unit_price,Name%20up,Missing%20desc&$count=yes",
+			wantErrs: map[string][]error{
+				OrderByParam: {ErrPropertyNotFound, httpbind.ErrSortNotValid, ErrPropertyNotFound},
+				CountParam:   {ErrCountNotValid},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := url.ParseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = test.binder.Bind(db, &testProduct{}, values)
+
+			var bindError httpbind.BindError
+			if !errors.As(err, &bindError) {
+				t.Fatalf("Bind() error = %v, want BindError", err)
+			}
+
+			if diff := cmp.Diff(test.wantErrs, bindError.ByParam(), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Bind() errors diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func setupDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
+	if err != nil {
+		t.Fatal("failed to connect database")
+	}
+
+	if err = db.AutoMigrate(&testProduct{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Use(pagorminator.PaGorminator{}); err != nil {
+		t.Fatal(err)
+	}
+
+	products := []*testProduct{
+		{Name: "A", Price: 10},
+		{Name: "B", Price: 5},
+		{Name: "C", Price: 20},
+		{Name: "D", Price: 20},
+		{Name: "E", Price: 1},
+	}
+	if err = db.Create(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
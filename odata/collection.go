@@ -0,0 +1,44 @@
+package odata
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/manuelarte/pagorminator/httpbind"
+)
+
+// Collection is the OData collection response, with the rows of the page and the annotations.
+//
+//go:structinit
+type Collection[T any] struct {
+	// Count is the @odata.count annotation, the total number of rows, only if $count=true.
+	Count *int64 `json:"@odata.count,omitempty"`
+	// NextLink is the @odata.nextLink annotation, the URL of the next page, only if there is a next page.
+	NextLink *string `json:"@odata.nextLink,omitempty"`
+	// Value are the rows of the page.
+	Value []T `json:"value"`
+}
+
+// NewCollection Create the collection response of a request that has run, given its rows.
+// The next link is the base URL with $skip pointing to the next page, the other query options are preserved.
+func NewCollection[T any](base *url.URL, request Request, value []T) Collection[T] {
+	if value == nil {
+		value = []T{}
+	}
+
+	collection := Collection[T]{Count: nil, NextLink: nil, Value: value}
+
+	if totalElements, ok := request.Pagination.TotalElements(); ok && request.Count {
+		collection.Count = &totalElements
+	}
+
+	if next, ok := request.Pagination.Next(); ok {
+		nextLink := httpbind.WithQuery(base, map[string]string{
+			SkipParam: strconv.Itoa(next.Offset()),
+			TopParam:  strconv.Itoa(next.Size()),
+		})
+		collection.NextLink = &nextLink
+	}
+
+	return collection
+}
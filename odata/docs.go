@@ -0,0 +1,5 @@
+// Package odata binds the OData query options `$top`, `$skip`, `$orderby` and `$count`,
+// https://docs.oasis-open.org/odata/odata/v4.01/odata-v4.01-part1-protocol.html#sec_SystemQueryOptions,
+// to page-based pagination, with the `$orderby` properties validated against the model schema.
+// It also creates the collection response with the `@odata.count` and `@odata.nextLink` annotations.
+package odata
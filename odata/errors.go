@@ -0,0 +1,14 @@
+package odata
+
+import "errors"
+
+var (
+	// ErrTopNotValid is returned when $top is not positive.
+	ErrTopNotValid = errors.New("$top must be positive")
+	// ErrSkipCantBeNegative is returned when $skip is negative.
+	ErrSkipCantBeNegative = errors.New("$skip can't be negative")
+	// ErrPropertyNotFound is returned when a $orderby property is not in the model.
+	ErrPropertyNotFound = errors.New("property not found")
+	// ErrCountNotValid is returned when $count is not true or false.
+	ErrCountNotValid = errors.New("$count must be true or false")
+)
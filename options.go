@@ -0,0 +1,203 @@
+package pagorminator
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// DefaultCallbackPrefix is the prefix of the plugin callback names, e.g. `pagorminator:count`.
+	DefaultCallbackPrefix = "pagorminator"
+)
+
+var (
+	// DefaultCountPosition is the position of the count callback, before running the query.
+	DefaultCountPosition = CallbackPosition{Before: "gorm:query", After: ""}
+	// DefaultSortPosition is the position of the sort callback, before running the query.
+	DefaultSortPosition = CallbackPosition{Before: "gorm:query", After: ""}
+	// DefaultCursorPosition is the position of the cursor callback, after running the query.
+	DefaultCursorPosition = CallbackPosition{Before: "gorm:after_query", After: ""}
+)
+
+// gormQueryCallbacks are the gorm query callbacks, in their running order.
+var gormQueryCallbacks = []string{"gorm:query", "gorm:preload", "gorm:after_query"}
+
+type (
+	// Option configures the PaGorminator plugin created with New.
+	Option func(*PaGorminator)
+
+	// CallbackPosition is the position of a plugin callback, before and/or after other gorm query callbacks,
+	// e.g. to run after other plugins callbacks.
+	// The count and sort callbacks must run before `gorm:query`, and the cursor callback after it.
+	CallbackPosition struct {
+		Before string
+		After  string
+	}
+
+	// callbacksConfig is the configuration of the plugin callbacks, the zero value uses the defaults.
+	callbacksConfig struct {
+		prefix string
+		count  *CallbackPosition
+		sort   *CallbackPosition
+		cursor *CallbackPosition
+	}
+)
+
+// New Create the plugin with the options.
+// The configuration is validated when the plugin is initialized by db.Use.
+func New(opts ...Option) PaGorminator {
+	var plugin PaGorminator
+	for _, opt := range opts {
+		opt(&plugin)
+	}
+
+	return plugin
+}
+
+// WithDebug logs the count queries through the gorm logger.
+func WithDebug() Option {
+	return func(p *PaGorminator) {
+		p.Debug = true
+	}
+}
+
+// WithLogger logs a structured record for each paginated query.
+func WithLogger(logger *slog.Logger) Option {
+	return func(p *PaGorminator) {
+		p.Logger = logger
+	}
+}
+
+// WithObserver sends the events of the paginated queries to the observer.
+func WithObserver(observer Observer) Option {
+	return func(p *PaGorminator) {
+		p.Observer = observer
+	}
+}
+
+// WithDeduplicateJoins selects distinct rows in the paginated queries that join has-many or many-to-many relationships.
+func WithDeduplicateJoins() Option {
+	return func(p *PaGorminator) {
+		p.DeduplicateJoins = true
+	}
+}
+
+// WithStrict adds the pagination misconfiguration errors to the query error.
+func WithStrict() Option {
+	return func(p *PaGorminator) {
+		p.Strict = true
+	}
+}
+
+// WithCountDB runs the count queries through the count DB connection, e.g. a read replica.
+func WithCountDB(countDB *gorm.DB) Option {
+	return func(p *PaGorminator) {
+		p.CountDB = countDB
+	}
+}
+
+// WithCountClauses applies the clauses to the count queries, e.g. dbresolver.Use("replica").
+func WithCountClauses(clauses ...clause.Expression) Option {
+	return func(p *PaGorminator) {
+		p.CountClauses = append(p.CountClauses, clauses...)
+	}
+}
+
+// WithCallbackPrefix sets the prefix of the callback names, DefaultCallbackPrefix by default.
+func WithCallbackPrefix(prefix string) Option {
+	return func(p *PaGorminator) {
+		p.callbacks.prefix = prefix
+	}
+}
+
+// WithCountCallback sets the position of the count callback, DefaultCountPosition by default.
+func WithCountCallback(position CallbackPosition) Option {
+	return func(p *PaGorminator) {
+		p.callbacks.count = &position
+	}
+}
+
+// WithSortCallback sets the position of the sort callback, DefaultSortPosition by default.
+func WithSortCallback(position CallbackPosition) Option {
+	return func(p *PaGorminator) {
+		p.callbacks.sort = &position
+	}
+}
+
+// WithCursorCallback sets the position of the cursor callback, DefaultCursorPosition by default.
+func WithCursorCallback(position CallbackPosition) Option {
+	return func(p *PaGorminator) {
+		p.callbacks.cursor = &position
+	}
+}
+
+// validate validates the callbacks configuration.
+//
+// Errors:
+//   - ErrCallbackPrefixNotValid if the prefix is blank.
+//   - ErrCallbackPositionNotValid if a position has no callbacks, or the same callback before and after,
+//     or the count and sort callbacks don't run before `gorm:query`, or the cursor callback doesn't run after it.
+func (c callbacksConfig) validate() error {
+	if c.prefix != "" && strings.TrimSpace(c.prefix) != c.prefix {
+		return fmt.Errorf("%w: %q", ErrCallbackPrefixNotValid, c.prefix)
+	}
+
+	positions := []struct {
+		name     string
+		position *CallbackPosition
+	}{{"count", c.count}, {"sort", c.sort}, {"cursor", c.cursor}}
+	for _, named := range positions {
+		name, position := named.name, named.position
+		switch {
+		case position == nil:
+		case position.Before == "" && position.After == "":
+			return fmt.Errorf("%w: %s callback without before nor after", ErrCallbackPositionNotValid, name)
+		case position.Before == position.After:
+			return fmt.Errorf("%w: %s callback before and after %q", ErrCallbackPositionNotValid, name, position.Before)
+		case name != "cursor" && !position.runsBeforeQuery():
+			return fmt.Errorf("%w: %s callback must run before %q", ErrCallbackPositionNotValid, name, gormQueryCallbacks[0])
+		case name == "cursor" && position.runsBeforeQuery():
+			return fmt.Errorf("%w: cursor callback must run after %q", ErrCallbackPositionNotValid, gormQueryCallbacks[0])
+		}
+	}
+
+	return nil
+}
+
+// runsBeforeQuery returns true if the position runs before `gorm:query`: it is before `gorm:query`,
+// or another callback that is not a later gorm query callback, and not after `gorm:query` nor a later one.
+// Without a before callback, it would run after the gorm query callbacks, since it is registered after them.
+func (c CallbackPosition) runsBeforeQuery() bool {
+	return c.Before != "" && !slices.Contains(gormQueryCallbacks[1:], c.Before) &&
+		!slices.Contains(gormQueryCallbacks, c.After)
+}
+
+func (c callbacksConfig) name(callback string) string {
+	return cmp.Or(c.prefix, DefaultCallbackPrefix) + ":" + callback
+}
+
+func (c callbacksConfig) countPosition() CallbackPosition {
+	return positionOrDefault(c.count, DefaultCountPosition)
+}
+
+func (c callbacksConfig) sortPosition() CallbackPosition {
+	return positionOrDefault(c.sort, DefaultSortPosition)
+}
+
+func (c callbacksConfig) cursorPosition() CallbackPosition {
+	return positionOrDefault(c.cursor, DefaultCursorPosition)
+}
+
+func positionOrDefault(position *CallbackPosition, defaultPosition CallbackPosition) CallbackPosition {
+	if position == nil {
+		return defaultPosition
+	}
+
+	return *position
+}
@@ -0,0 +1,192 @@
+package pagorminator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts          []Option
+		wantCallbacks []string
+	}{
+		"zero value": {
+			wantCallbacks: []string{"pagorminator:count", "pagorminator:sort", "pagorminator:cursor:next"},
+		},
+		"debug and deduplicate joins": {
+			opts:          []Option{WithDebug(), WithDeduplicateJoins()},
+			wantCallbacks: []string{"pagorminator:count", "pagorminator:sort", "pagorminator:cursor:next"},
+		},
+		"callback prefix": {
+			opts:          []Option{WithCallbackPrefix("paging")},
+			wantCallbacks: []string{"paging:count", "paging:sort", "paging:cursor:next"},
+		},
+		"count after other callback": {
+			opts: []Option{
+				WithCountCallback(CallbackPosition{Before: "gorm:query", After: "tenant:scope"}),
+				WithSortCallback(CallbackPosition{Before: "gorm:query", After: "tenant:scope"}),
+				WithCursorCallback(CallbackPosition{Before: "", After: "gorm:after_query"}),
+			},
+			wantCallbacks: []string{"pagorminator:count", "pagorminator:sort", "pagorminator:cursor:next"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db := setupDBWithPlugin(t, New(test.opts...))
+			migrateTestStructs(t, db, 5)
+
+			for _, callback := range test.wantCallbacks {
+				if db.Callback().Query().Get(callback) == nil {
+					t.Errorf("expected callback %q to be registered", callback)
+				}
+			}
+
+			pageRequest := pagepagination.Must(0, 2)
+
+			var products []*TestStruct
+			if err := db.Clauses(pageRequest).Find(&products).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			if len(products) != 2 {
+				t.Errorf("expected 2 products, got %d", len(products))
+			}
+
+			if total, ok := pageRequest.TotalElements(); !ok || total != 5 {
+				t.Errorf("expected 5 total elements, got %d", total)
+			}
+		})
+	}
+}
+
+func TestNewCallbackOrder(t *testing.T) {
+	t.Parallel()
+
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.AutoMigrate(&TestStruct{}); err != nil {
+		t.Fatal(err)
+	}
+
+	migrateTestStructs(t, db, 5)
+
+	err = db.Callback().Query().Before("gorm:query").Register("tenant:scope", func(db *gorm.DB) {
+		db.Where("code > ?", 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Use(New(WithCountCallback(CallbackPosition{Before: "gorm:query", After: "tenant:scope"})))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pageRequest := pagepagination.Must(0, 2)
+
+	var products []*TestStruct
+	if err = db.Clauses(pageRequest).Find(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if total, ok := pageRequest.TotalElements(); !ok || total != 4 {
+		t.Errorf("expected 4 total elements counted after the tenant scope, got %d", total)
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts    []Option
+		wantErr error
+	}{
+		"prefix with spaces": {
+			opts:    []Option{WithCallbackPrefix(" paging ")},
+			wantErr: ErrCallbackPrefixNotValid,
+		},
+		"count callback without position": {
+			opts:    []Option{WithCountCallback(CallbackPosition{})},
+			wantErr: ErrCallbackPositionNotValid,
+		},
+		"sort callback before and after the same callback": {
+			opts:    []Option{WithSortCallback(CallbackPosition{Before: "gorm:query", After: "gorm:query"})},
+			wantErr: ErrCallbackPositionNotValid,
+		},
+		"count callback after gorm:query": {
+			opts:    []Option{WithCountCallback(CallbackPosition{After: "gorm:query"})},
+			wantErr: ErrCallbackPositionNotValid,
+		},
+		"count callback only after another callback": {
+			opts:    []Option{WithCountCallback(CallbackPosition{After: "tenant:scope"})},
+			wantErr: ErrCallbackPositionNotValid,
+		},
+		"sort callback before gorm:after_query": {
+			opts:    []Option{WithSortCallback(CallbackPosition{Before: "gorm:after_query"})},
+			wantErr: ErrCallbackPositionNotValid,
+		},
+		"cursor callback before gorm:query": {
+			opts:    []Option{WithCursorCallback(CallbackPosition{Before: "gorm:query"})},
+			wantErr: ErrCallbackPositionNotValid,
+		},
+		"cursor callback before another callback": {
+			opts:    []Option{WithCursorCallback(CallbackPosition{Before: "my:plugin"})},
+			wantErr: ErrCallbackPositionNotValid,
+		},
+		"cursor callback after gorm:query and before another callback": {
+			opts: []Option{WithCursorCallback(CallbackPosition{Before: "my:plugin", After: "gorm:query"})},
+		},
+		"cursor callback without position": {
+			opts:    []Option{WithCursorCallback(CallbackPosition{})},
+			wantErr: ErrCallbackPositionNotValid,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Use(New(test.opts...))
+			if diff := cmp.Diff(test.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCallbacksConfigValidateOrder(t *testing.T) {
+	t.Parallel()
+
+	config := callbacksConfig{
+		cursor: &CallbackPosition{Before: "my:plugin"},
+		sort:   &CallbackPosition{After: "gorm:query"},
+		count:  &CallbackPosition{After: "gorm:query"},
+	}
+
+	want := `callback position is not valid: count callback must run before "gorm:query"`
+	for range 10 {
+		if err := config.validate(); err == nil || err.Error() != want {
+			t.Fatalf("validate() = %v, want %s", err, want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package pagorminator
+
+import (
+	"fmt"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+// Page is the response envelope with the content of a page and its pagination metadata.
+//
+//go:structinit
+type Page[T any] struct {
+	// Content is the page rows.
+	Content []T `json:"content"`
+	// Page is the page number, always zero for cursor pagination.
+	Page int `json:"page"`
+	// Size is the page size.
+	Size int `json:"size"`
+	// TotalElements is the total number of elements across all the pages.
+	TotalElements int64 `json:"totalElements"`
+	// TotalPages is the total number of pages.
+	TotalPages int `json:"totalPages"`
+	// HasNext is true if there is a next page.
+	HasNext bool `json:"hasNext"`
+	// HasPrev is true if there is a previous page, always false for cursor pagination,
+	// as the cursor tokens only go forwards.
+	HasPrev bool `json:"hasPrev"`
+	// NextCursor is the token of the next page, only for cursor pagination.
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// NewPage Create a page given the content and the pagination used to query it.
+// The pagination metadata is a snapshot, so it is not affected by later changes in the pagination.
+//
+// Errors:
+//   - Any error encoding the cursor tokens.
+func NewPage[T any](content []T, pagination Pagination) (Page[T], error) {
+	if content == nil {
+		content = []T{}
+	}
+
+	totalElements, _ := pagination.TotalElements()
+	page := Page[T]{
+		Content:       content,
+		Page:          0,
+		Size:          pagination.Size(),
+		TotalElements: totalElements,
+		TotalPages:    totalPages(totalElements, pagination.Size()),
+		HasNext:       false,
+		HasPrev:       false,
+		NextCursor:    "",
+	}
+
+	switch typed := pagination.(type) {
+	case *pagepagination.Pagination:
+		_, hasNext := typed.Next()
+		_, hasPrev := typed.Prev()
+		page.Page, page.TotalPages = typed.Page(), typed.TotalPages()
+		page.HasNext, page.HasPrev = bool(hasNext), bool(hasPrev)
+	case *idpagination.Pagination:
+		_, hasNext := typed.Next()
+		_, hasPrev := typed.Prev()
+		page.Page, page.TotalPages = typed.Page(), typed.TotalPages()
+		page.HasNext, page.HasPrev = bool(hasNext), bool(hasPrev)
+	case *cursorpagination.Pagination:
+		next, hasNext := typed.Next()
+		if hasNext {
+			nextCursor, err := next.Token()
+			if err != nil {
+				return Page[T]{}, fmt.Errorf("encoding next cursor: %w", err)
+			}
+
+			page.HasNext, page.NextCursor = true, nextCursor
+		}
+	}
+
+	return page, nil
+}
+
+// Map returns a page with the content transformed by the mapper and the same pagination metadata.
+func Map[T, U any](page Page[T], mapper func(T) U) Page[U] {
+	content := make([]U, len(page.Content))
+	for i, element := range page.Content {
+		content[i] = mapper(element)
+	}
+
+	return Page[U]{
+		Content:       content,
+		Page:          page.Page,
+		Size:          page.Size,
+		TotalElements: page.TotalElements,
+		TotalPages:    page.TotalPages,
+		HasNext:       page.HasNext,
+		HasPrev:       page.HasPrev,
+		NextCursor:    page.NextCursor,
+	}
+}
+
+func totalPages(totalElements int64, size int) int {
+	if size <= 0 {
+		return 1
+	}
+
+	return int((totalElements + int64(size) - 1) / int64(size))
+}
@@ -0,0 +1,166 @@
+package pagorminator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+func TestNewPage(t *testing.T) {
+	t.Parallel()
+
+	toMigrate := func() []*TestStruct {
+		return []*TestStruct{
+			{Model: gorm.Model{ID: 1}, Code: "A", Price: 1},
+			{Model: gorm.Model{ID: 2}, Code: "B", Price: 2},
+			{Model: gorm.Model{ID: 3}, Code: "C", Price: 3},
+		}
+	}
+
+	tests := map[string]struct {
+		pagination Pagination
+		want       Page[string]
+	}{
+		"first page": {
+			pagination: pagepagination.Must(0, 2, pagegeneric.Asc("code")),
+			want: Page[string]{
+				Content:       []string{"A", "B"},
+				Page:          0,
+				Size:          2,
+				TotalElements: 3,
+				TotalPages:    2,
+				HasNext:       true,
+				HasPrev:       false,
+			},
+		},
+		"last page": {
+			pagination: pagepagination.Must(1, 2, pagegeneric.Asc("code")),
+			want: Page[string]{
+				Content:       []string{"C"},
+				Page:          1,
+				Size:          2,
+				TotalElements: 3,
+				TotalPages:    2,
+				HasNext:       false,
+				HasPrev:       true,
+			},
+		},
+		"cursor first page": {
+			pagination: cursorpagination.Must(2, cursorpagination.Asc("id", nil)),
+			want: Page[string]{
+				Content:       []string{"A", "B"},
+				Size:          2,
+				TotalElements: 3,
+				TotalPages:    2,
+				HasNext:       true,
+				HasPrev:       false,
+				NextCursor:    mustToken(t, cursorpagination.Must(2, cursorpagination.Asc("id", uint(2)))),
+			},
+		},
+		"cursor last page": {
+			pagination: cursorpagination.Must(2, cursorpagination.Asc("id", 2)),
+			want: Page[string]{
+				Content:       []string{"C"},
+				Size:          2,
+				TotalElements: 3,
+				TotalPages:    2,
+				HasNext:       false,
+				HasPrev:       false,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db := setupDB(t)
+
+			data := toMigrate()
+			if txCreate := db.CreateInBatches(&data, len(data)); txCreate.Error != nil {
+				t.Fatal(txCreate.Error)
+			}
+
+			var products []*TestStruct
+			if tx := db.Clauses(test.pagination).Find(&products); tx.Error != nil {
+				t.Fatal(tx.Error)
+			}
+
+			page, err := NewPage(products, test.pagination)
+			if err != nil {
+				t.Fatalf("NewPage() unexpected error: %v", err)
+			}
+
+			got := Map(page, func(product *TestStruct) string {
+				return product.Code
+			})
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("NewPage() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPageJSON(t *testing.T) {
+	t.Parallel()
+
+	emptyPage, err := NewPage[int](nil, pagepagination.Must(0, 10))
+	if err != nil {
+		t.Fatalf("NewPage() unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		page Page[int]
+		want string
+	}{
+		"empty content is not null": {
+			page: emptyPage,
+			want: `{"content":[],"page":0,"size":10,"totalElements":0,"totalPages":0,"hasNext":false,"hasPrev":false}`,
+		},
+		"with cursors": {
+			page: Page[int]{
+				Content:       []int{1, 2},
+				Size:          2,
+				TotalElements: 5,
+				TotalPages:    3,
+				HasNext:       true,
+				HasPrev:       false,
+				NextCursor:    "next",
+			},
+			want: `{"content":[1,2],"page":0,"size":2,"totalElements":5,"totalPages":3,"hasNext":true,"hasPrev":false,` +
+				`"nextCursor":"next"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(test.page)
+			if err != nil {
+				t.Fatalf("json.Marshal() unexpected error: %v", err)
+			}
+
+			if string(got) != test.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func mustToken(t *testing.T, pagination *cursorpagination.Pagination) string {
+	t.Helper()
+
+	token, err := pagination.Token()
+	if err != nil {
+		t.Fatalf("Token() unexpected error: %v", err)
+	}
+
+	return token
+}
@@ -1,10 +1,45 @@
 package pagegeneric
 
+import "gorm.io/gorm"
+
 const (
 	// PagorminatorClause is the key used to store the pagination clause in the GORM statement context.
 	PagorminatorClause = "pagorminator:clause"
-	// PagorminatorCursorWhereSQL stores the cursor pagination where SQL to strip from count queries.
+	// PagorminatorClauses stores the number of different pagination clauses applied to the statement,
+	// only set when there is more than one, so the plugin can detect them.
+	PagorminatorClauses = "pagorminator:clauses"
+	// PagorminatorLimit stores the limit clause applied before the pagination clause, e.g. db.Limit(50).Clauses(page),
+	// since the pagination limit replaces it, so the plugin can detect it.
+	PagorminatorLimit = "pagorminator:limit"
+	// PagorminatorSort stores the sort that needs to be resolved against the statement by the plugin.
+	PagorminatorSort = "pagorminator:sort"
+	// PagorminatorCursorWhereSQL stored the cursor pagination where SQL to strip from count queries.
+	//
+	// Deprecated: the cursor values are applied as a cursorpagination.Condition where expression, use it instead.
 	PagorminatorCursorWhereSQL = "pagorminator:cursor:where:sql"
-	// PagorminatorCursorWhereVars stores the cursor pagination where vars to strip from count queries.
+	// PagorminatorCursorWhereVars stored the cursor pagination where vars to strip from count queries.
+	//
+	// Deprecated: the cursor values are applied as a cursorpagination.Condition where expression, use it instead.
 	PagorminatorCursorWhereVars = "pagorminator:cursor:where:vars"
 )
+
+// SetClause stores the pagination clause in the statement, counting the different pagination clauses applied,
+// and the limit clause applied before the first one.
+// The last pagination clause applied is the one stored.
+func SetClause(tx *gorm.DB, pagination any) {
+	previous, hasPrevious := tx.Get(PagorminatorClause)
+	if limit, hasLimit := tx.Statement.Clauses["LIMIT"]; hasLimit && !hasPrevious {
+		tx.Set(PagorminatorLimit, limit.Expression)
+	}
+
+	if hasPrevious && previous != pagination {
+		clauses := 1
+		if value, hasClauses := tx.Get(PagorminatorClauses); hasClauses {
+			clauses, _ = value.(int)
+		}
+
+		tx.Set(PagorminatorClauses, clauses+1)
+	}
+
+	tx.Set(PagorminatorClause, pagination)
+}
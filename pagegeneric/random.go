@@ -0,0 +1,67 @@
+package pagegeneric
+
+import (
+	"fmt"
+)
+
+// randomModulus is the prime used as modulus to shuffle the rows, 2^31+11.
+// Being prime, the hash is a permutation of the column values below it, so there are no ties between rows.
+// Being above the int4 range, PostgreSQL types it as bigint, so the hash of int4 columns is computed as bigint
+// and doesn't overflow, the other dialects already compute the integer arithmetic with 64 bits.
+const randomModulus = 2147483659
+
+var _ ExpressionOrder = new(Random)
+
+// Random is a deterministic pseudo-random order.
+// The rows are shuffled by hashing an integer column, usually the primary key, with a seed,
+// so the same seed always produces the same order and the pages never overlap.
+//
+//go:structinit
+type Random struct {
+	column string
+	seed   uint64
+}
+
+// NewRandom creates a pseudo-random order shuffling the rows by hashing the column with the seed.
+func NewRandom(column string, seed uint64) Random {
+	return Random{column: column, seed: seed}
+}
+
+// Column returns the column name of the order.
+func (r Random) Column() string {
+	return r.column
+}
+
+// Seed returns the seed used to shuffle the rows.
+func (r Random) Seed() uint64 {
+	return r.seed
+}
+
+// GormString returns the string representation of the order for gorm.
+func (r Random) GormString() string {
+	expression, _ := r.Expression(r.column)
+
+	return expression + " ASC"
+}
+
+// Expression returns the SQL expression hashing the operand with the seed.
+func (r Random) Expression(operand string, operandVars ...any) (string, []any) {
+	multiplier, increment := r.coefficients()
+
+	return fmt.Sprintf("((%s %% %d) * %d + %d) %% %d", operand, randomModulus, multiplier, increment, randomModulus),
+		operandVars
+}
+
+func (r Random) order() {}
+
+// coefficients returns the multiplier and increment derived from the seed.
+// The multiplier is never zero, so the hash keeps being a permutation.
+func (r Random) coefficients() (uint64, uint64) {
+	// splitmix64, so close seeds produce unrelated orders.
+	mixed := r.seed + 0x9e3779b97f4a7c15
+	mixed = (mixed ^ (mixed >> 30)) * 0xbf58476d1ce4e5b9
+	mixed = (mixed ^ (mixed >> 27)) * 0x94d049bb133111eb
+	mixed ^= mixed >> 31
+
+	return 1 + mixed%(randomModulus-1), (mixed >> 32) % randomModulus
+}
@@ -0,0 +1,77 @@
+package pagegeneric
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestRandomGormString(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		order Order
+		other Order
+		equal bool
+	}{
+		"same seed, same order": {
+			order: NewRandom("id", 42),
+			other: NewRandom("id", 42),
+			equal: true,
+		},
+		"different seed, different order": {
+			order: NewRandom("id", 42),
+			other: NewRandom("id", 43),
+			equal: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.order.GormString() == test.other.GormString()
+			if got != test.equal {
+				t.Errorf("%q == %q is %t, want %t", test.order.GormString(), test.other.GormString(), got, test.equal)
+			}
+		})
+	}
+}
+
+func TestRandomCoefficients(t *testing.T) {
+	t.Parallel()
+
+	for seed := range uint64(1000) {
+		multiplier, increment := NewRandom("id", seed).coefficients()
+		if multiplier == 0 || multiplier >= randomModulus {
+			t.Errorf("seed %d: multiplier %d out of range", seed, multiplier)
+		}
+
+		if increment >= randomModulus {
+			t.Errorf("seed %d: increment %d out of range", seed, increment)
+		}
+
+		// the hash of the largest operand fits a bigint.
+		if (randomModulus-1)*multiplier+increment > math.MaxInt64 {
+			t.Errorf("seed %d: hash of %d overflows a bigint", seed, uint64(randomModulus-1))
+		}
+	}
+}
+
+func TestRandomExpression(t *testing.T) {
+	t.Parallel()
+
+	random := NewRandom("id", 7)
+	multiplier, increment := random.coefficients()
+
+	gotSQL, gotVars := random.Expression("?", 10)
+
+	wantSQL := fmt.Sprintf("((? %% 2147483659) * %d + %d) %% 2147483659", multiplier, increment)
+	if gotSQL != wantSQL {
+		t.Errorf("random.Expression() = %q, want %q", gotSQL, wantSQL)
+	}
+
+	if len(gotVars) != 1 || gotVars[0] != 10 {
+		t.Errorf("random.Expression() vars = %v, want [10]", gotVars)
+	}
+}
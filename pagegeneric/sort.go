@@ -2,12 +2,18 @@ package pagegeneric
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
 	_ Order = new(Asc)
 	_ Order = new(Desc)
+	_ Order = new(AscField)
+	_ Order = new(DescField)
 )
 
 type (
@@ -18,6 +24,15 @@ type (
 		order()
 	}
 
+	// ExpressionOrder is an Order that sorts by an SQL expression computed from the column,
+	// instead of by the column itself. The expression is always sorted ascending.
+	ExpressionOrder interface {
+		Order
+		// Expression returns the SQL expression, and its vars, computed from the operand,
+		// which can be the column or a placeholder bound to the operand vars.
+		Expression(operand string, operandVars ...any) (string, []any)
+	}
+
 	// Sort represents a collection of Order.
 	Sort []Order
 
@@ -26,6 +41,14 @@ type (
 
 	// Desc is descending order.
 	Desc string
+
+	// AscField is ascending order by a Go struct field name, e.g. "CreatedAt".
+	// The field is resolved to its column when the statement is executed.
+	AscField string
+
+	// DescField is descending order by a Go struct field name, e.g. "CreatedAt".
+	// The field is resolved to its column when the statement is executed.
+	DescField string
 )
 
 // Column returns the column name of the order.
@@ -52,6 +75,30 @@ func (d Desc) GormString() string {
 
 func (d Desc) order() {}
 
+// Column returns the Go struct field name of the order.
+func (a AscField) Column() string {
+	return string(a)
+}
+
+// GormString returns the string representation of the order for gorm, using the unresolved field name.
+func (a AscField) GormString() string {
+	return fmt.Sprintf("%s ASC", a)
+}
+
+func (a AscField) order() {}
+
+// Column returns the Go struct field name of the order.
+func (d DescField) Column() string {
+	return string(d)
+}
+
+// GormString returns the string representation of the order for gorm, using the unresolved field name.
+func (d DescField) GormString() string {
+	return fmt.Sprintf("%s DESC", d)
+}
+
+func (d DescField) order() {}
+
 // NewSort Creates sort (slices of [Order]).
 func NewSort(orders ...Order) Sort {
 	return orders
@@ -71,3 +118,141 @@ func (s Sort) String() string {
 
 	return strings.Join(orderStrings, ", ")
 }
+
+// Merge returns a new sort with the orders of the sort followed by the orders of others.
+// Orders referring to a column already present are dropped, so the first occurrence takes precedence.
+func (s Sort) Merge(others ...Sort) Sort {
+	merged := slices.Clone(s)
+	for _, other := range others {
+		merged = append(merged, other...)
+	}
+
+	return merged.Dedup()
+}
+
+// Dedup returns a new sort without the orders referring to a column already present,
+// keeping the first occurrence of each column.
+func (s Sort) Dedup() Sort {
+	deduped := make(Sort, 0, len(s))
+
+	for _, order := range s {
+		if !slices.ContainsFunc(deduped, func(existing Order) bool {
+			return sameColumn(existing.Column(), order.Column())
+		}) {
+			deduped = append(deduped, order)
+		}
+	}
+
+	return deduped
+}
+
+// HasFieldOrders returns true if the sort contains orders by Go struct field names
+// that need to be resolved against the statement.
+func (s Sort) HasFieldOrders() bool {
+	for _, order := range s {
+		switch order.(type) {
+		case AscField, DescField:
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasVars returns true if the sort contains expression orders with bind vars,
+// so it needs to be applied with Expression instead of String.
+func (s Sort) HasVars() bool {
+	for _, order := range s {
+		if expressionOrder, ok := order.(ExpressionOrder); ok {
+			if _, vars := expressionOrder.Expression(order.Column()); len(vars) > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Expression returns the sort as a clause expression, binding the vars of the expression orders.
+func (s Sort) Expression() clause.Expression {
+	return sortExpression(s)
+}
+
+// Resolve returns a copy of the sort with the Go struct field name orders replaced by column orders.
+// The fields are looked up in the statement schema, falling back to the configured naming strategy.
+func (s Sort) Resolve(stmt *gorm.Statement) Sort {
+	resolved := make(Sort, len(s))
+	for i, order := range s {
+		switch typed := order.(type) {
+		case AscField:
+			resolved[i] = Asc(resolveFieldColumn(stmt, string(typed)))
+		case DescField:
+			resolved[i] = Desc(resolveFieldColumn(stmt, string(typed)))
+		default:
+			resolved[i] = order
+		}
+	}
+
+	return resolved
+}
+
+// sameColumn returns true if both columns are the same, ignoring the identifier quotes,
+// and the table qualifier when only one of them is qualified.
+func sameColumn(a, b string) bool {
+	unquote := strings.NewReplacer("`", "", `"`, "", "[", "", "]", "")
+	a, b = unquote.Replace(a), unquote.Replace(b)
+
+	if a == b {
+		return true
+	}
+
+	aTable, aName, aQualified := strings.Cut(a, ".")
+	bTable, bName, bQualified := strings.Cut(b, ".")
+
+	switch {
+	case aQualified && !bQualified:
+		return aName == bTable
+	case !aQualified && bQualified:
+		return aTable == bName
+	default:
+		return false
+	}
+}
+
+// sortExpression builds the sort binding the vars of the expression orders.
+type sortExpression Sort
+
+// Build builds the comma separated orders.
+func (s sortExpression) Build(builder clause.Builder) {
+	for i, order := range s {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+
+		expressionOrder, ok := order.(ExpressionOrder)
+		if !ok {
+			builder.WriteString(order.GormString())
+
+			continue
+		}
+
+		expression, vars := expressionOrder.Expression(order.Column())
+		clause.Expr{SQL: expression + " ASC", Vars: vars}.Build(builder)
+	}
+}
+
+func resolveFieldColumn(stmt *gorm.Statement, fieldName string) string {
+	column := clause.Column{Name: stmt.NamingStrategy.ColumnName(stmt.Table, fieldName)}
+
+	if stmt.Schema != nil {
+		if field := stmt.Schema.LookUpField(fieldName); field != nil && field.DBName != "" {
+			column.Name = field.DBName
+		}
+	}
+
+	if stmt.Table != "" {
+		column.Table = clause.CurrentTable
+	}
+
+	return stmt.Quote(column)
+}
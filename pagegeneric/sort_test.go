@@ -2,6 +2,11 @@ package pagegeneric
 
 import (
 	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 func TestOrderString(t *testing.T) {
@@ -60,3 +65,124 @@ func TestSortString(t *testing.T) {
 		})
 	}
 }
+
+func TestSortResolve(t *testing.T) {
+	t.Parallel()
+
+	type embedded struct {
+		Amount uint
+	}
+
+	type model struct {
+		ID        uint
+		CreatedAt time.Time
+		Price     embedded `gorm:"embedded;embeddedPrefix:price_"`
+	}
+
+	tests := map[string]struct {
+		namingStrategy schema.NamingStrategy
+		sort           Sort
+		want           string
+	}{
+		"column orders are kept": {
+			sort: NewSort(Asc("id"), Desc("created_at")),
+			want: "id ASC, created_at DESC",
+		},
+		"field orders are resolved": {
+			sort: NewSort(AscField("CreatedAt"), DescField("ID")),
+			want: "`models`.`created_at` ASC, `models`.`id` DESC",
+		},
+		"embedded field with prefix": {
+			sort: NewSort(DescField("Amount")),
+			want: "`models`.`price_amount` DESC",
+		},
+		"custom naming strategy": {
+			namingStrategy: schema.NamingStrategy{TablePrefix: "t_", NoLowerCase: true},
+			sort:           NewSort(AscField("CreatedAt")),
+			want:           "`t_models`.`CreatedAt` ASC",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{DryRun: true, NamingStrategy: test.namingStrategy})
+			if err != nil {
+				t.Fatalf("failed to open db: %v", err)
+			}
+
+			stmt := &gorm.Statement{DB: db}
+			if err = stmt.Parse(&model{}); err != nil {
+				t.Fatalf("failed to parse model: %v", err)
+			}
+
+			got := test.sort.Resolve(stmt).String()
+			if got != test.want {
+				t.Errorf("test.sort.Resolve().String() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSortMerge(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		sort   Sort
+		others []Sort
+		want   string
+	}{
+		"merge with empty sort": {
+			sort:   Unsorted(),
+			others: []Sort{NewSort(Desc("created_at"), Asc("id"))},
+			want:   "created_at DESC, id ASC",
+		},
+		"merge appends the orders": {
+			sort:   NewSort(Asc("price")),
+			others: []Sort{NewSort(Desc("created_at"), Asc("id"))},
+			want:   "price ASC, created_at DESC, id ASC",
+		},
+		"merge drops duplicated columns, first occurrence wins": {
+			sort:   NewSort(Asc("created_at")),
+			others: []Sort{NewSort(Desc("created_at"), Asc("id")), NewSort(Desc("id"))},
+			want:   "created_at ASC, id ASC",
+		},
+		"merge considers quoted columns the same": {
+			sort:   NewSort(Asc("`products`.`id`")),
+			others: []Sort{NewSort(Desc("products.id"))},
+			want:   "`products`.`id` ASC",
+		},
+		"merge considers qualified and unqualified columns the same": {
+			sort:   NewSort(Asc("code")),
+			others: []Sort{NewSort(Desc("`products`.`code`"), Desc("`prices`.`id`"), Asc("id"))},
+			want:   "code ASC, `prices`.`id` DESC",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.sort.Merge(test.others...).String()
+			if got != test.want {
+				t.Errorf("test.sort.Merge().String() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSortDedup(t *testing.T) {
+	t.Parallel()
+
+	sort := NewSort(Asc("price"), Desc("id"), Desc("price"))
+
+	got := sort.Dedup().String()
+	if want := "price ASC, id DESC"; got != want {
+		t.Errorf("sort.Dedup().String() = %q, want %q", got, want)
+	}
+
+	if len(sort) != 3 {
+		t.Errorf("sort.Dedup() modified the original sort: %v", sort)
+	}
+}
@@ -0,0 +1,63 @@
+package pagegeneric
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var _ ExpressionOrder = new(ValueList)
+
+// ValueList is an order by an explicit list of values, e.g. the priorities "urgent", "high", "normal", "low".
+// The rows are sorted by the position of the column value in the list,
+// and the rows with values not in the list are sorted last.
+//
+//go:structinit
+type ValueList struct {
+	column string
+	values []any
+}
+
+// NewValueList creates an order sorting the column by the position of its value in the values list.
+func NewValueList(column string, values ...any) ValueList {
+	return ValueList{column: column, values: slices.Clone(values)}
+}
+
+// Column returns the column name of the order.
+func (v ValueList) Column() string {
+	return v.column
+}
+
+// Values returns the ordered list of values.
+func (v ValueList) Values() []any {
+	return slices.Clone(v.values)
+}
+
+// GormString returns the string representation of the order for gorm.
+// The values are represented as placeholders, use Expression to get them as vars.
+func (v ValueList) GormString() string {
+	expression, _ := v.Expression(v.column)
+
+	return expression + " ASC"
+}
+
+// Expression returns the SQL CASE expression ranking the operand by the position of its value in the list.
+func (v ValueList) Expression(operand string, operandVars ...any) (string, []any) {
+	var expression strings.Builder
+
+	expression.WriteString("CASE ")
+	expression.WriteString(operand)
+
+	for i := range v.values {
+		fmt.Fprintf(&expression, " WHEN ? THEN %d", i)
+	}
+
+	fmt.Fprintf(&expression, " ELSE %d END", len(v.values))
+
+	vars := make([]any, 0, len(operandVars)+len(v.values))
+	vars = append(vars, operandVars...)
+
+	return expression.String(), append(vars, v.values...)
+}
+
+func (v ValueList) order() {}
@@ -0,0 +1,80 @@
+package pagegeneric
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValueListExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		order       ValueList
+		operand     string
+		operandVars []any
+		wantSQL     string
+		wantVars    []any
+	}{
+		"column operand": {
+			order:    NewValueList("priority", "urgent", "high", "low"),
+			operand:  "priority",
+			wantSQL:  "CASE priority WHEN ? THEN 0 WHEN ? THEN 1 WHEN ? THEN 2 ELSE 3 END",
+			wantVars: []any{"urgent", "high", "low"},
+		},
+		"placeholder operand": {
+			order:       NewValueList("priority", "urgent", "high"),
+			operand:     "?",
+			operandVars: []any{"high"},
+			wantSQL:     "CASE ? WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END",
+			wantVars:    []any{"high", "urgent", "high"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotSQL, gotVars := test.order.Expression(test.operand, test.operandVars...)
+			if gotSQL != test.wantSQL {
+				t.Errorf("test.order.Expression() = %q, want %q", gotSQL, test.wantSQL)
+			}
+
+			if diff := cmp.Diff(test.wantVars, gotVars); diff != "" {
+				t.Errorf("test.order.Expression() vars diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSortHasVars(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		sort Sort
+		want bool
+	}{
+		"column orders": {
+			sort: NewSort(Asc("id"), DescField("CreatedAt")),
+			want: false,
+		},
+		"random order": {
+			sort: NewSort(NewRandom("id", 1)),
+			want: false,
+		},
+		"value list order": {
+			sort: NewSort(Asc("id"), NewValueList("priority", "urgent")),
+			want: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.sort.HasVars(); got != test.want {
+				t.Errorf("test.sort.HasVars() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
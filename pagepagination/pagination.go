@@ -14,7 +14,9 @@ import (
 type Pagination struct {
 	page int
 	size int
-	sort pagegeneric.Sort
+	// offset is the exact offset, a multiple of the size unless created with NewOffset.
+	offset int
+	sort   pagegeneric.Sort
 
 	mu               sync.RWMutex
 	totalElements    int64
@@ -43,7 +45,40 @@ func New(page, size int, orders ...pagegeneric.Order) (*Pagination, error) {
 
 	sort := pagegeneric.NewSort(orders...)
 
-	return &Pagination{page: page, size: size, sort: sort}, nil
+	return &Pagination{page: page, size: size, offset: page * size, sort: sort}, nil
+}
+
+// NewOffset Create page given the exact offset, size, and orders, e.g. for APIs with skip and limit parameters.
+// The offset doesn't need to be a multiple of the size, and the page is the page of the first row.
+// It returns the pagination object and any error encountered.
+//
+// Errors:
+//   - ErrOffsetCantBeNegative if the offset value is below zero.
+//   - ErrSizeCantBeNegative if the size value is below zero.
+//   - ErrSizeNotAllowed if the size is zero and the offset is greater than zero.
+func NewOffset(offset, size int, orders ...pagegeneric.Order) (*Pagination, error) {
+	if offset < 0 {
+		return nil, ErrOffsetCantBeNegative
+	}
+
+	if size < 0 {
+		return nil, ErrSizeCantBeNegative
+	}
+
+	if offset > 0 && size == 0 {
+		return nil, ErrSizeNotAllowed
+	}
+
+	return newOffset(offset, size, pagegeneric.NewSort(orders...)), nil
+}
+
+func newOffset(offset, size int, sort pagegeneric.Sort) *Pagination {
+	page := 0
+	if size > 0 {
+		page = offset / size
+	}
+
+	return &Pagination{page: page, size: size, offset: offset, sort: sort}
 }
 
 // Must Create page given page, size, and orders.
@@ -59,10 +94,10 @@ func Must(page, size int, orders ...pagegeneric.Order) *Pagination {
 
 // UnPaged Create an unpaged request (no pagination is applied).
 func UnPaged() *Pagination {
-	return &Pagination{page: 0, size: 0}
+	return &Pagination{page: 0, size: 0, offset: 0}
 }
 
-// Page Get the page number.
+// Page Get the page number, the page of the first row if the offset is not a multiple of the size.
 func (p *Pagination) Page() int {
 	return p.page
 }
@@ -79,7 +114,7 @@ func (p *Pagination) Sort() pagegeneric.Sort {
 
 // Offset Get the offset.
 func (p *Pagination) Offset() int {
-	return p.page * p.size
+	return p.offset
 }
 
 // TotalPages Get the total number of pages.
@@ -119,7 +154,7 @@ func (p *Pagination) SetTotalElements(totalElements int64) error {
 
 // IsUnPaged Check whether the pagination is applicable.
 func (p *Pagination) IsUnPaged() bool {
-	return p.page == 0 && p.size == 0
+	return p.offset == 0 && p.size == 0
 }
 
 // IsSort Checks if sorting is also requested.
@@ -150,17 +185,12 @@ func (p *Pagination) Next() (*Pagination, pagegeneric.PrevNextPossible) {
 		return nil, pagegeneric.NoTotalElements
 	}
 
-	totalPages := 1
-	if p.size > 0 {
-		totalPages = calculateTotalPages(totalElements, p.size)
-	}
-
-	nextPage := p.page + 1
-	if nextPage >= totalPages {
+	nextOffset := p.offset + p.size
+	if p.size == 0 || int64(nextOffset) >= totalElements {
 		return nil, pagegeneric.NoNextPage
 	}
 
-	return Must(nextPage, p.size, p.Sort()...), true
+	return newOffset(nextOffset, p.size, p.Sort()), true
 }
 
 // Prev Get the previous page pagination request.
@@ -177,12 +207,11 @@ func (p *Pagination) Prev() (*Pagination, pagegeneric.PrevNextPossible) {
 		return nil, pagegeneric.NoTotalElements
 	}
 
-	prevPage := p.page - 1
-	if prevPage < 0 {
+	if p.offset == 0 {
 		return nil, pagegeneric.NoPrevPage
 	}
 
-	return Must(prevPage, p.size, p.Sort()...), true
+	return newOffset(max(p.offset-p.size, 0), p.size, p.Sort()), true
 }
 
 func calculateTotalPages(totalElements int64, size int) int {
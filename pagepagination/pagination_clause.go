@@ -10,14 +10,20 @@ import (
 // ModifyStatement Modify the query clause to apply pagination.
 func (p *Pagination) ModifyStatement(stm *gorm.Statement) {
 	tx := stm.DB
-	tx.Set(pagegeneric.PagorminatorClause, p)
+	pagegeneric.SetClause(tx, p)
 
 	if !p.IsUnPaged() {
 		tx = tx.Limit(p.size).Offset(p.Offset())
 	}
 
 	if p.IsSort() {
-		tx.Order(p.sort.String())
+		if p.sort.HasFieldOrders() || p.sort.HasVars() {
+			// field names can only be resolved once the statement schema is parsed,
+			// and the order by vars are bound by the plugin.
+			tx.Set(pagegeneric.PagorminatorSort, p.sort)
+		} else {
+			tx.Order(p.sort.String())
+		}
 	}
 }
 
@@ -186,8 +186,9 @@ func TestNext(t *testing.T) {
 		}
 
 		want := &Pagination{
-			page: 1,
-			size: 2,
+			page:   1,
+			size:   2,
+			offset: 2,
 			sort: pagegeneric.Sort{
 				pagegeneric.Asc("id"),
 				pagegeneric.Desc("price"),
@@ -203,3 +204,94 @@ func TestNext(t *testing.T) {
 		}
 	})
 }
+
+func TestNewOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		offset      int
+		size        int
+		wantPage    int
+		wantPrev    int
+		wantNext    int
+		wantHasPrev pagegeneric.PrevNextPossible
+		wantHasNext pagegeneric.PrevNextPossible
+		wantErr     error
+	}{
+		"offset multiple of size": {
+			offset:      10,
+			size:        10,
+			wantPage:    1,
+			wantPrev:    0,
+			wantNext:    20,
+			wantHasPrev: true,
+			wantHasNext: true,
+		},
+		"offset not multiple of size": {
+			offset:      5,
+			size:        10,
+			wantPage:    0,
+			wantPrev:    0,
+			wantNext:    15,
+			wantHasPrev: true,
+			wantHasNext: true,
+		},
+		"last rows": {
+			offset:      20,
+			size:        15,
+			wantPage:    1,
+			wantPrev:    5,
+			wantHasPrev: true,
+			wantHasNext: pagegeneric.NoNextPage,
+		},
+		"first rows": {
+			offset:      0,
+			size:        15,
+			wantPage:    0,
+			wantNext:    15,
+			wantHasPrev: pagegeneric.NoPrevPage,
+			wantHasNext: true,
+		},
+		"negative offset": {
+			offset:  -1,
+			size:    10,
+			wantErr: ErrOffsetCantBeNegative,
+		},
+		"offset without size": {
+			offset:  5,
+			wantErr: ErrSizeNotAllowed,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := NewOffset(test.offset, test.size, pagegeneric.Asc("id"))
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("NewOffset() error = %v, want %v", err, test.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if p.Offset() != test.offset || p.Page() != test.wantPage {
+				t.Errorf("NewOffset() = offset %d, page %d, want offset %d, page %d",
+					p.Offset(), p.Page(), test.offset, test.wantPage)
+			}
+
+			_ = p.SetTotalElements(30)
+
+			prev, hasPrev := p.Prev()
+			if hasPrev != test.wantHasPrev || hasPrev && prev.Offset() != test.wantPrev {
+				t.Errorf("Prev() = %v, %v, want offset %d, %v", prev, hasPrev, test.wantPrev, test.wantHasPrev)
+			}
+
+			next, hasNext := p.Next()
+			if hasNext != test.wantHasNext || hasNext && next.Offset() != test.wantNext {
+				t.Errorf("Next() = %v, %v, want offset %d, %v", next, hasNext, test.wantNext, test.wantHasNext)
+			}
+		})
+	}
+}
@@ -2,24 +2,57 @@ package pagorminator
 
 import (
 	"fmt"
+	"log/slog"
 	"reflect"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 
 	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
 	"github.com/manuelarte/pagorminator/pagegeneric"
 )
 
 const (
-	countKey = "pagorminator.count"
+	// countKey marks the count session, so its statement is not paginated.
+	// It is not the CountModeKey setting, nor the pagorminator:count callback name.
+	countKey = "pagorminator.count_session"
+	// statementKey stores the paginated statement, the settings are copied to the preload queries,
+	// which are not paginated.
+	statementKey = "pagorminator.statement"
 )
 
 var _ gorm.Plugin = new(PaGorminator)
 
 // PaGorminator Gorm plugin to add pagination information to your pagination query.
+// The zero value is ready to use, see New to configure the callbacks.
 type PaGorminator struct {
+	// Debug logs the count queries through the gorm logger, see Logger for structured records.
 	Debug bool
+	// Logger receives a structured record for each paginated query, no records are logged if nil.
+	Logger *slog.Logger
+	// DeduplicateJoins selects distinct rows in the paginated queries that join has-many or many-to-many relationships,
+	// so the pages don't contain the same row several times.
+	// The total elements of these queries always count the distinct primary keys.
+	DeduplicateJoins bool
+	// Observer receives the events of the paginated queries, no events are sent if nil.
+	Observer Observer
+	// Strict adds the pagination misconfiguration errors to the query error, e.g. a cursor column that is not
+	// a field of the model, or a limit conflicting with the pagination.
+	// Otherwise, the cursor errors are only recorded on the pagination, see cursorpagination Err,
+	// and the conflicts are logged as warnings.
+	Strict bool
+	// CountDB runs the count queries through its connection, e.g. a read replica, when the totals can tolerate
+	// the replica lag. It must use the same dialect. The count fails over to the primary if the replica count fails.
+	CountDB *gorm.DB
+	// CountClauses are applied to the count queries, e.g. dbresolver.Use("replica") to use a named resolver.
+	// The count fails over to the primary, without the clauses, if the count fails.
+	CountClauses []clause.Expression
+
+	callbacks callbacksConfig
 }
 
 // Name returns the name of the plugin.
@@ -28,70 +61,270 @@ func (p PaGorminator) Name() string {
 }
 
 // Initialize initializes the plugin and registers the callback for counting total elements.
+//
+// Errors:
+//   - ErrCallbackPrefixNotValid or ErrCallbackPositionNotValid if the callbacks configuration is not valid.
+//   - ErrCountDBNotValid if the count DB dialect is not the db one.
+//   - Any error registering the callbacks.
 func (p PaGorminator) Initialize(db *gorm.DB) error {
-	if err := db.Callback().Query().
-		Before("gorm:query").Register("pagorminator:count", p.count); err != nil {
+	if err := p.callbacks.validate(); err != nil {
+		return err
+	}
+
+	if p.CountDB != nil && p.CountDB.Dialector.Name() != db.Dialector.Name() {
+		return fmt.Errorf("%w: %q, want %q", ErrCountDBNotValid, p.CountDB.Dialector.Name(), db.Dialector.Name())
+	}
+
+	query := db.Callback().Query()
+
+	countPosition := p.callbacks.countPosition()
+	if err := query.Before(countPosition.Before).After(countPosition.After).
+		Register(p.callbacks.name("count"), p.count); err != nil {
 		return fmt.Errorf("failed to register count callback: %w", err)
 	}
 
-	if err := db.Callback().Query().
-		Before("gorm:after_query").Register("pagorminator:cursor:next", p.cursorNext); err != nil {
+	if err := query.Before(p.callbacks.name("count")).
+		Register(p.callbacks.name("conflicts"), p.checkConflicts); err != nil {
+		return fmt.Errorf("failed to register conflicts callback: %w", err)
+	}
+
+	sortPosition := p.callbacks.sortPosition()
+	if err := query.Before(sortPosition.Before).After(sortPosition.After).
+		Register(p.callbacks.name("sort"), p.sort); err != nil {
+		return fmt.Errorf("failed to register sort callback: %w", err)
+	}
+
+	cursorPosition := p.callbacks.cursorPosition()
+	if err := query.Before(cursorPosition.Before).After(cursorPosition.After).
+		Register(p.callbacks.name("cursor:next"), p.cursorNext); err != nil {
 		return fmt.Errorf("failed to register cursor callback: %w", err)
 	}
 
+	if err := query.Before(countPosition.Before).After(countPosition.After).
+		Register(p.callbacks.name("raw"), p.paginateRaw); err != nil {
+		return fmt.Errorf("failed to register raw callback: %w", err)
+	}
+
+	if err := db.Callback().Row().Before("gorm:row").Register(p.callbacks.name("raw"), p.paginateRaw); err != nil {
+		return fmt.Errorf("failed to register raw row callback: %w", err)
+	}
+
+	if err := db.Callback().Row().After("gorm:row").
+		Register(p.callbacks.name("cursor:row"), p.cursorRow); err != nil {
+		return fmt.Errorf("failed to register cursor row callback: %w", err)
+	}
+
 	return nil
 }
 
 func (p PaGorminator) count(db *gorm.DB) {
-	if db.Statement.Schema == nil && db.Statement.Table == "" {
+	// the raw statements are paginated by the raw callback.
+	if db.Error != nil || isRawStatement(db.Statement) || db.Statement.Schema == nil && db.Statement.Table == "" {
 		return
 	}
 
-	if pageable, ok := p.getPageRequest(db); ok && !pageable.IsTotalElementsSet() {
-		tx := db.Session(&gorm.Session{Context: db.Statement.Context})
-		if p.Debug {
-			tx = tx.Debug()
-		}
+	pageable, ok := p.getPageRequest(db)
+	if !ok {
+		return
+	}
+
+	p.observe(db.Statement.Context, pageAppliedEvent(db.Statement.Table, pageable))
+
+	toManyJoins := hasToManyJoins(db.Statement)
+	if toManyJoins && p.DeduplicateJoins && len(db.Statement.Selects) == 0 {
+		deduplicateJoins(db.Statement)
+	}
 
-		delete(tx.Statement.Clauses, "LIMIT")
-		delete(tx.Statement.Clauses, "OFFSET")
-		p.removeCursorWhereClause(tx)
+	if p.skipCount(db, pageable) {
+		return
+	}
 
-		var totalElements int64
+	duration, err := p.countTotalElements(db, pageable, toManyJoins)
+	p.logPage(db, pageable, CountStatusCounted, duration, err)
 
-		tx = tx.Set(countKey, true)
-		tx.Count(&totalElements)
+	if err != nil {
+		_ = db.AddError(err)
+	}
+}
 
-		if tx.Error != nil {
-			_ = db.AddError(tx.Error)
-			return
-		}
+// skipCount returns true, logging the page, if the total elements are not counted because of the count mode.
+// The id pagination total elements are the number of ids, so they are never counted, even with CountForce.
+func (p PaGorminator) skipCount(db *gorm.DB, pageable Pagination) bool {
+	_, fixedTotal := pageable.(*idpagination.Pagination)
+	if mode := countModeOf(db); fixedTotal || mode == CountSkip || mode != CountForce && pageable.IsTotalElementsSet() {
+		p.logPage(db, pageable, CountStatusSkipped, 0, nil)
 
-		_ = pageable.SetTotalElements(totalElements)
+		return true
 	}
+
+	return false
 }
 
-func (p PaGorminator) removeCursorWhereClause(tx *gorm.DB) {
-	cursorWhereSQLRaw, hasCursorWhereSQL := tx.Get(pagegeneric.PagorminatorCursorWhereSQL)
-	if !hasCursorWhereSQL {
+// countTotalElements counts the total elements in a new session without the pagination,
+// and sets them in the pagination. It returns the count duration.
+// With has-many or many-to-many joins, the distinct primary keys are counted instead of the joined rows.
+func (p PaGorminator) countTotalElements(db *gorm.DB, pageable Pagination, toManyJoins bool) (time.Duration, error) {
+	tx := db.Session(&gorm.Session{Context: db.Statement.Context})
+	if p.Debug {
+		tx = tx.Debug()
+	}
+
+	delete(tx.Statement.Clauses, "LIMIT")
+	delete(tx.Statement.Clauses, "OFFSET")
+	p.removeCursorWhereClause(tx)
+
+	if toManyJoins {
+		tx = distinctPrimaryKeys(db, tx)
+	}
+
+	return p.runCount(db, pageable, func(totalElements *int64) (string, error) {
+		var countSQL string
+
+		err := p.countOnReplica(db, tx.Set(countKey, true), func(tx *gorm.DB) error {
+			countSQL = p.countSQL(tx)
+
+			return tx.Count(totalElements).Error
+		})
+
+		return countSQL, err
+	})
+}
+
+// runCount runs the count, sending the count events, and sets the total elements in the pagination.
+// It returns the count duration.
+func (p PaGorminator) runCount(
+	db *gorm.DB,
+	pageable Pagination,
+	count func(totalElements *int64) (string, error),
+) (time.Duration, error) {
+	var totalElements int64
+
+	paginationType := paginationTypeOf(pageable)
+	p.observe(db.Statement.Context, CountStartedEvent{Type: paginationType, Table: db.Statement.Table})
+	start := time.Now()
+
+	countSQL, err := count(&totalElements)
+	duration := time.Since(start)
+
+	p.observe(db.Statement.Context, CountFinishedEvent{
+		Type:          paginationType,
+		Table:         db.Statement.Table,
+		Duration:      duration,
+		SQL:           countSQL,
+		Err:           err,
+		TotalElements: totalElements,
+	})
+
+	if err != nil {
+		return duration, err
+	}
+
+	_ = pageable.SetTotalElements(totalElements)
+
+	return duration, nil
+}
+
+// countSQL returns the SQL of the count statement when the count is observed.
+// gorm resets the statement SQL after running it, so it is built on a dry run of the same count statement.
+func (p PaGorminator) countSQL(tx *gorm.DB) string {
+	if p.Observer == nil {
+		return ""
+	}
+
+	var totalElements int64
+
+	return tx.Session(&gorm.Session{DryRun: true}).Count(&totalElements).Statement.SQL.String()
+}
+
+func (p PaGorminator) sort(db *gorm.DB) {
+	// the raw statements are sorted by the raw callback.
+	if db.Error != nil || isRawStatement(db.Statement) {
 		return
 	}
 
-	cursorWhereSQL, ok := cursorWhereSQLRaw.(string)
-	if !ok || cursorWhereSQL == "" {
+	pagination, ok := p.getPageRequest(db)
+	if !ok {
 		return
 	}
 
-	cursorWhereVarsRaw, hasCursorWhereVars := tx.Get(pagegeneric.PagorminatorCursorWhereVars)
-	if !hasCursorWhereVars {
+	p.applySort(db, pagination)
+}
+
+// applySort adds the pagination sort with field names or vars, and the model default sort, to the order by clause.
+func (p PaGorminator) applySort(db *gorm.DB, pagination Pagination) {
+	var sort pagegeneric.Sort
+	if sorter, isSorter := pagination.(interface{ Sort() pagegeneric.Sort }); isSorter {
+		sort = sorter.Sort().Resolve(db.Statement)
+	}
+
+	// the pagination sort is applied here instead of in the pagination clause when it contains field names.
+	if pendingSortRaw, hasPendingSort := db.Get(pagegeneric.PagorminatorSort); hasPendingSort {
+		if pendingSort, isSort := pendingSortRaw.(pagegeneric.Sort); isSort && len(pendingSort) > 0 {
+			addOrderBy(db, pendingSort.Resolve(db.Statement))
+		}
+	}
+
+	defaultSort := p.getDefaultSort(db)
+	if len(defaultSort) == 0 {
 		return
 	}
 
-	cursorWhereVars, ok := cursorWhereVarsRaw.([]any)
+	merged := sort.Merge(defaultSort.Resolve(db.Statement))
+	addOrderBy(db, merged[len(sort.Dedup()):])
+}
+
+func (p PaGorminator) getDefaultSort(db *gorm.DB) pagegeneric.Sort {
+	if db.Statement.Schema == nil {
+		return nil
+	}
+
+	model, ok := reflect.New(db.Statement.Schema.ModelType).Interface().(DefaultSorter)
 	if !ok {
+		return nil
+	}
+
+	return model.DefaultSort()
+}
+
+// addOrderBy appends the sort to the order by clause.
+// The order by is built as an expression, so the sort vars are bound.
+func addOrderBy(db *gorm.DB, sort pagegeneric.Sort) {
+	if len(sort) == 0 {
 		return
 	}
 
+	orderByClause := db.Statement.Clauses["ORDER BY"]
+	orderByClause.Name = "ORDER BY"
+
+	expressions := make(orderByExpressions, 0, 2)
+	if orderBy, ok := orderByClause.Expression.(clause.OrderBy); ok {
+		if orderBy.Expression != nil {
+			expressions = append(expressions, orderBy.Expression)
+		} else if len(orderBy.Columns) > 0 {
+			expressions = append(expressions, clause.OrderBy{Columns: orderBy.Columns})
+		}
+	}
+
+	orderByClause.Expression = clause.OrderBy{Expression: append(expressions, sort.Expression())}
+	db.Statement.Clauses["ORDER BY"] = orderByClause
+}
+
+// orderByExpressions joins the order by expressions with commas.
+type orderByExpressions []clause.Expression
+
+// Build builds the comma separated expressions.
+func (o orderByExpressions) Build(builder clause.Builder) {
+	for i, expression := range o {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+
+		expression.Build(builder)
+	}
+}
+
+// removeCursorWhereClause removes the cursor condition from the count session, so all the rows are counted.
+func (p PaGorminator) removeCursorWhereClause(tx *gorm.DB) {
 	whereClause, hasWhere := tx.Statement.Clauses["WHERE"]
 	if !hasWhere {
 		return
@@ -102,7 +335,7 @@ func (p PaGorminator) removeCursorWhereClause(tx *gorm.DB) {
 		return
 	}
 
-	filteredExpressions, removed := removeCursorWhereExpr(where.Exprs, cursorWhereSQL, cursorWhereVars)
+	filteredExpressions, removed := removeCursorCondition(where.Exprs)
 	if !removed {
 		return
 	}
@@ -117,16 +350,14 @@ func (p PaGorminator) removeCursorWhereClause(tx *gorm.DB) {
 	tx.Statement.Clauses["WHERE"] = whereClause
 }
 
-func removeCursorWhereExpr(
-	expressions []clause.Expression,
-	cursorWhereSQL string,
-	cursorWhereVars []any,
-) ([]clause.Expression, bool) {
+// removeCursorCondition removes the cursor conditions from the expressions, also inside grouped conditions.
+// It returns the filtered expressions and whether any cursor condition was removed.
+func removeCursorCondition(expressions []clause.Expression) ([]clause.Expression, bool) {
 	filteredExpressions := make([]clause.Expression, 0, len(expressions))
 	removedAny := false
 
 	for _, expression := range expressions {
-		filteredExpression, removed, keep := removeExpression(expression, cursorWhereSQL, cursorWhereVars)
+		filteredExpression, removed, keep := removeExpression(expression)
 		if removed {
 			removedAny = true
 		}
@@ -139,18 +370,12 @@ func removeCursorWhereExpr(
 	return filteredExpressions, removedAny
 }
 
-func removeExpression(
-	expression clause.Expression,
-	cursorWhereSQL string,
-	cursorWhereVars []any,
-) (clause.Expression, bool, bool) {
+func removeExpression(expression clause.Expression) (clause.Expression, bool, bool) {
 	switch expressionTyped := expression.(type) {
-	case clause.Expr:
-		if expressionTyped.SQL == cursorWhereSQL && reflect.DeepEqual(expressionTyped.Vars, cursorWhereVars) {
-			return nil, true, false
-		}
+	case cursorpagination.Condition:
+		return nil, true, false
 	case clause.AndConditions:
-		filteredExpressions, removed := removeCursorWhereExpr(expressionTyped.Exprs, cursorWhereSQL, cursorWhereVars)
+		filteredExpressions, removed := removeCursorCondition(expressionTyped.Exprs)
 		if !removed {
 			return expressionTyped, false, true
 		}
@@ -163,7 +388,7 @@ func removeExpression(
 
 		return expressionTyped, true, true
 	case clause.OrConditions:
-		filteredExpressions, removed := removeCursorWhereExpr(expressionTyped.Exprs, cursorWhereSQL, cursorWhereVars)
+		filteredExpressions, removed := removeCursorCondition(expressionTyped.Exprs)
 		if !removed {
 			return expressionTyped, false, true
 		}
@@ -176,7 +401,7 @@ func removeExpression(
 
 		return expressionTyped, true, true
 	case clause.NotConditions:
-		filteredExpressions, removed := removeCursorWhereExpr(expressionTyped.Exprs, cursorWhereSQL, cursorWhereVars)
+		filteredExpressions, removed := removeCursorCondition(expressionTyped.Exprs)
 		if !removed {
 			return expressionTyped, false, true
 		}
@@ -194,7 +419,7 @@ func removeExpression(
 }
 
 func (p PaGorminator) cursorNext(db *gorm.DB) {
-	if db.Error != nil || db.Statement.Dest == nil || db.Statement.Schema == nil {
+	if db.Error != nil || db.Statement.Dest == nil {
 		return
 	}
 
@@ -208,44 +433,98 @@ func (p PaGorminator) cursorNext(db *gorm.DB) {
 		return
 	}
 
-	schema := db.Statement.Schema
-	dest := db.Statement.Dest
-	destValue := reflect.ValueOf(dest)
+	latestLen, latestValues, err := latestCursorValues(db.Statement, cursorPagination.Cursors())
+	if err != nil {
+		cursorPagination.SetLatestQueryError(err)
+
+		if p.Strict {
+			_ = db.AddError(err)
+		}
 
-	if destValue.Kind() == reflect.Pointer {
-		destValue = destValue.Elem()
-	} else {
 		return
 	}
 
-	if destValue.Kind() != reflect.Slice {
+	cursorPagination.SetLatestQueryValues(latestLen, latestValues)
+
+	if latestLen == 0 {
 		return
 	}
 
-	latestLen := destValue.Len()
-	if latestLen == 0 {
-		cursorPagination.SetLatestQueryValues(latestLen, nil)
+	p.observe(db.Statement.Context, CursorCapturedEvent{
+		Table:  db.Statement.Table,
+		Rows:   latestLen,
+		Values: latestValues,
+	})
+}
+
+// cursorRow records ErrCursorValuesNotCaptured in the cursor pagination of the Row queries, e.g. Raw(sql).Scan(&rows),
+// since the rows are scanned after the callbacks, so Next doesn't return a page from a previous query.
+func (p PaGorminator) cursorRow(db *gorm.DB) {
+	if db.Error != nil {
 		return
 	}
 
-	destValue = destValue.Index(latestLen - 1)
-	if destValue.Kind() == reflect.Pointer {
-		destValue = destValue.Elem()
-	} else {
+	pagination, hasPagination := p.getPageRequest(db)
+	if !hasPagination {
 		return
 	}
 
-	latestValues := make(map[string]any, len(cursorPagination.Cursors()))
-	for _, colName := range getCursorColumns(cursorPagination.Cursors()) {
-		if field := schema.LookUpField(colName); field != nil {
-			fieldValue, _ := field.ValueOf(db.Statement.Context, destValue)
-			latestValues[colName] = fieldValue
-		} else {
-			return
+	if cursorPagination, ok := pagination.(*cursorpagination.Pagination); ok {
+		cursorPagination.SetLatestQueryError(ErrCursorValuesNotCaptured)
+	}
+}
+
+// latestCursorValues returns the number of rows of the query destination, and the cursor values of the last row.
+//
+// Errors:
+//   - UnsupportedDestinationError if the destination is not a pointer to a slice of structs or struct pointers.
+//   - CursorColumnNotInSchemaError if a cursor column is not a field of the model schema.
+func latestCursorValues(stmt *gorm.Statement, cursors []cursorpagination.Cursor) (int, map[string]any, error) {
+	destValue := reflect.ValueOf(stmt.Dest)
+	if destValue.Kind() != reflect.Pointer || destValue.Elem().Kind() != reflect.Slice ||
+		!isStructType(destValue.Elem().Type().Elem()) {
+		return 0, nil, UnsupportedDestinationError{Type: destValue.Type()}
+	}
+
+	fields := make(map[string]*schema.Field, len(cursors))
+	for _, colName := range getCursorColumns(cursors) {
+		var field *schema.Field
+		if stmt.Schema != nil {
+			// the cursor columns can be qualified with the statement table, e.g. to paginate with joins.
+			field = stmt.Schema.LookUpField(strings.TrimPrefix(colName, stmt.Table+"."))
+		}
+
+		if field == nil {
+			return 0, nil, CursorColumnNotInSchemaError{Column: colName, Table: stmt.Table}
 		}
+
+		fields[colName] = field
 	}
 
-	cursorPagination.SetLatestQueryValues(latestLen, latestValues)
+	destValue = destValue.Elem()
+
+	latestLen := destValue.Len()
+	if latestLen == 0 {
+		return 0, nil, nil
+	}
+
+	latestValue := reflect.Indirect(destValue.Index(latestLen - 1))
+
+	latestValues := make(map[string]any, len(cursors))
+	for colName, field := range fields {
+		latestValues[colName], _ = field.ValueOf(stmt.Context, latestValue)
+	}
+
+	return latestLen, latestValues, nil
+}
+
+// isStructType returns true if the type is a struct or a pointer to a struct.
+func isStructType(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ.Kind() == reflect.Struct
 }
 
 func (p PaGorminator) getPageRequest(db *gorm.DB) (Pagination, bool) {
@@ -269,6 +548,11 @@ func (p PaGorminator) getPageRequest(db *gorm.DB) (Pagination, bool) {
 		return nil, false
 	}
 
+	if statement, loaded := db.Statement.Settings.LoadOrStore(statementKey, db.Statement); loaded &&
+		statement != db.Statement {
+		return nil, false
+	}
+
 	return paginationClause, true
 }
 
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
 	"github.com/manuelarte/pagorminator/pagegeneric"
 	"github.com/manuelarte/pagorminator/pagepagination"
 )
@@ -681,10 +687,81 @@ func TestWithPreloadAndWhere(t *testing.T) {
 func TestWithJoins(t *testing.T) {
 	t.Parallel()
 
+	findProducts := func(tx *gorm.DB) ([]string, error) {
+		var products []*TestProduct
+		err := tx.Joins("Price").Find(&products).Error
+
+		codes := make([]string, len(products))
+		for i, product := range products {
+			codes[i] = product.Code
+		}
+
+		return codes, err
+	}
+
+	const (
+		orderItemsJoin = "JOIN test_order_items ON test_order_items.test_order_id = test_orders.id"
+		parcelsJoin    = "JOIN test_parcels ON test_parcels.test_shipment_carrier = test_shipments.carrier " +
+			"AND test_parcels.test_shipment_code = test_shipments.code"
+	)
+
+	findOrders := func(join, where string) func(tx *gorm.DB) ([]string, error) {
+		return func(tx *gorm.DB) ([]string, error) {
+			tx = tx.Joins(join)
+			if where != "" {
+				tx = tx.Where(where)
+			}
+
+			var orders []*TestOrder
+			err := tx.Find(&orders).Error
+
+			codes := make([]string, len(orders))
+			for i, order := range orders {
+				codes[i] = order.Code
+			}
+
+			return codes, err
+		}
+	}
+
+	findShipments := func(join string) func(tx *gorm.DB) ([]string, error) {
+		return func(tx *gorm.DB) ([]string, error) {
+			var shipments []*TestShipment
+			err := tx.Joins(join).Find(&shipments).Error
+
+			codes := make([]string, len(shipments))
+			for i, shipment := range shipments {
+				codes[i] = shipment.Code
+			}
+
+			return codes, err
+		}
+	}
+
+	// the shipments with the same code have different carriers, so they are different shipments.
+	newShipments := func() []*TestShipment {
+		return []*TestShipment{
+			{Carrier: "a", Code: "1", Parcels: []TestParcel{{Name: "a"}, {Name: "b"}, {Name: "c"}}},
+			{Carrier: "a", Code: "2", Parcels: []TestParcel{{Name: "a"}, {Name: "b"}}},
+			{Carrier: "b", Code: "1", Parcels: []TestParcel{{Name: "a"}}},
+		}
+	}
+
+	newOrders := func() []*TestOrder {
+		return []*TestOrder{
+			{Code: "1", Items: []TestOrderItem{{Name: "a"}, {Name: "b"}, {Name: "c"}}},
+			{Code: "2", Items: []TestOrderItem{{Name: "a"}}},
+			{Code: "3", Items: []TestOrderItem{{Name: "b"}, {Name: "c"}}},
+		}
+	}
+
 	tests := map[string]struct {
-		toMigrate     []*TestProduct
+		toMigrate     any
+		deduplicate   bool
+		find          func(tx *gorm.DB) ([]string, error)
 		pageRequest   *pagepagination.Pagination
 		wantPage      *wantPagePagination
+		wantCodes     []string
 		cursorRequest *cursorpagination.Pagination
 		wantCursor    *wantCursorPagination
 	}{
@@ -692,6 +769,7 @@ func TestWithJoins(t *testing.T) {
 			toMigrate: []*TestProduct{
 				{Code: "1", Price: TestPrice{Amount: 1, Currency: "EUR"}},
 			},
+			find:        findProducts,
 			pageRequest: pagepagination.UnPaged(),
 			wantPage: &wantPagePagination{
 				page:             0,
@@ -699,6 +777,7 @@ func TestWithJoins(t *testing.T) {
 				totalElements:    1,
 				totalElementsSet: true,
 			},
+			wantCodes:     []string{"1"},
 			cursorRequest: cursorpagination.UnPaged(),
 			wantCursor: &wantCursorPagination{
 				size:             0,
@@ -712,6 +791,7 @@ func TestWithJoins(t *testing.T) {
 				{Code: "1", Price: TestPrice{Amount: 1, Currency: "EUR"}},
 				{Code: "2", Price: TestPrice{Amount: 2, Currency: "EUR"}},
 			},
+			find:        findProducts,
 			pageRequest: pagepagination.Must(0, 1),
 			wantPage: &wantPagePagination{
 				page:             0,
@@ -719,6 +799,7 @@ func TestWithJoins(t *testing.T) {
 				totalElements:    2,
 				totalElementsSet: true,
 			},
+			wantCodes:     []string{"1"},
 			cursorRequest: cursorpagination.Must(1, cursorpagination.Asc("test_products.id", nil)),
 			wantCursor: &wantCursorPagination{
 				size:             1,
@@ -727,6 +808,171 @@ func TestWithJoins(t *testing.T) {
 				totalElementsSet: true,
 			},
 		},
+		"has many join, count distinct orders": {
+			toMigrate:   newOrders(),
+			find:        findOrders(orderItemsJoin, ""),
+			pageRequest: pagepagination.Must(0, 2, pagegeneric.Asc("test_orders.id")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("test_orders.id")},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+			wantCodes:     []string{"1", "1"},
+			cursorRequest: cursorpagination.Must(2, cursorpagination.Asc("test_orders.id", nil)),
+			wantCursor: &wantCursorPagination{
+				size:             2,
+				cursors:          []cursorpagination.Cursor{cursorpagination.Asc("test_orders.id", nil)},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+		},
+		"has many join, count distinct orders, filtered by item": {
+			toMigrate:   newOrders(),
+			find:        findOrders(orderItemsJoin, "test_order_items.name IN ('b', 'c')"),
+			pageRequest: pagepagination.Must(0, 2, pagegeneric.Asc("test_orders.id")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("test_orders.id")},
+				totalElements:    2,
+				totalElementsSet: true,
+			},
+			wantCodes:     []string{"1", "1"},
+			cursorRequest: cursorpagination.Must(2, cursorpagination.Asc("test_orders.id", nil)),
+			wantCursor: &wantCursorPagination{
+				size:             2,
+				cursors:          []cursorpagination.Cursor{cursorpagination.Asc("test_orders.id", nil)},
+				totalElements:    2,
+				totalElementsSet: true,
+			},
+		},
+		"has many join, deduplicated page": {
+			toMigrate:   newOrders(),
+			deduplicate: true,
+			find:        findOrders(orderItemsJoin, ""),
+			pageRequest: pagepagination.Must(0, 2, pagegeneric.Asc("test_orders.id")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("test_orders.id")},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+			wantCodes:     []string{"1", "2"},
+			cursorRequest: cursorpagination.Must(2, cursorpagination.Asc("test_orders.id", nil)),
+			wantCursor: &wantCursorPagination{
+				size:             2,
+				cursors:          []cursorpagination.Cursor{cursorpagination.Asc("test_orders.id", nil)},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+		},
+		"has many relationship join, deduplicated page": {
+			toMigrate:   newOrders(),
+			deduplicate: true,
+			find:        findOrders("Items", ""),
+			pageRequest: pagepagination.Must(0, 2, pagegeneric.Asc("test_orders.id")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("test_orders.id")},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+			wantCodes:     []string{"1", "2"},
+			cursorRequest: cursorpagination.Must(2, cursorpagination.Asc("test_orders.id", nil)),
+			wantCursor: &wantCursorPagination{
+				size:             2,
+				cursors:          []cursorpagination.Cursor{cursorpagination.Asc("test_orders.id", nil)},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+		},
+		"has many relationship join, deduplicated page, filtered by item": {
+			toMigrate:   newOrders(),
+			deduplicate: true,
+			find:        findOrders("Items", "Items.name IN ('b', 'c')"),
+			pageRequest: pagepagination.Must(0, 2, pagegeneric.Asc("test_orders.id")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("test_orders.id")},
+				totalElements:    2,
+				totalElementsSet: true,
+			},
+			wantCodes:     []string{"1", "3"},
+			cursorRequest: cursorpagination.Must(2, cursorpagination.Asc("test_orders.id", nil)),
+			wantCursor: &wantCursorPagination{
+				size:             2,
+				cursors:          []cursorpagination.Cursor{cursorpagination.Asc("test_orders.id", nil)},
+				totalElements:    2,
+				totalElementsSet: true,
+			},
+		},
+		"composite primary key, has many join, count distinct shipments": {
+			toMigrate:   newShipments(),
+			find:        findShipments(parcelsJoin),
+			pageRequest: pagepagination.Must(0, 2, pagegeneric.Asc("test_shipments.carrier"), pagegeneric.Asc("test_shipments.code")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("test_shipments.carrier"), pagegeneric.Asc("test_shipments.code")},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+			wantCodes:     []string{"1", "1"},
+			cursorRequest: cursorpagination.Must(2, cursorpagination.Asc("test_shipments.carrier", nil), cursorpagination.Asc("test_shipments.code", nil)),
+			wantCursor: &wantCursorPagination{
+				size:             2,
+				cursors:          []cursorpagination.Cursor{cursorpagination.Asc("test_shipments.carrier", nil), cursorpagination.Asc("test_shipments.code", nil)},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+		},
+		"composite primary key, has many join, deduplicated page": {
+			toMigrate:   newShipments(),
+			deduplicate: true,
+			find:        findShipments(parcelsJoin),
+			pageRequest: pagepagination.Must(0, 2, pagegeneric.Asc("test_shipments.carrier"), pagegeneric.Asc("test_shipments.code")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("test_shipments.carrier"), pagegeneric.Asc("test_shipments.code")},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+			wantCodes:     []string{"1", "2"},
+			cursorRequest: cursorpagination.Must(2, cursorpagination.Asc("test_shipments.carrier", nil), cursorpagination.Asc("test_shipments.code", nil)),
+			wantCursor: &wantCursorPagination{
+				size:             2,
+				cursors:          []cursorpagination.Cursor{cursorpagination.Asc("test_shipments.carrier", nil), cursorpagination.Asc("test_shipments.code", nil)},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+		},
+		"composite primary key, has many relationship join, deduplicated page": {
+			toMigrate:   newShipments(),
+			deduplicate: true,
+			find:        findShipments("Parcels"),
+			pageRequest: pagepagination.Must(0, 2, pagegeneric.Asc("test_shipments.carrier"), pagegeneric.Asc("test_shipments.code")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("test_shipments.carrier"), pagegeneric.Asc("test_shipments.code")},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+			wantCodes:     []string{"1", "2"},
+			cursorRequest: cursorpagination.Must(2, cursorpagination.Asc("test_shipments.carrier", nil), cursorpagination.Asc("test_shipments.code", nil)),
+			wantCursor: &wantCursorPagination{
+				size:             2,
+				cursors:          []cursorpagination.Cursor{cursorpagination.Asc("test_shipments.carrier", nil), cursorpagination.Asc("test_shipments.code", nil)},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -734,24 +980,29 @@ func TestWithJoins(t *testing.T) {
 			t.Parallel()
 
 			db := setupDB(t)
+			if test.deduplicate {
+				db = setupDBWithPlugin(t, PaGorminator{DeduplicateJoins: true})
+			}
 
-			txCreate := db.CreateInBatches(&test.toMigrate, len(test.toMigrate))
-			if txCreate.Error != nil {
+			if txCreate := db.Create(test.toMigrate); txCreate.Error != nil {
 				t.Fatal(txCreate.Error)
 			}
 
 			{
-				tx := db.Clauses(test.pageRequest).Joins("Price").Find(&[]*TestProduct{})
-				if tx.Error != nil {
-					t.Fatal(tx.Error)
+				codes, err := test.find(db.Clauses(test.pageRequest))
+				if err != nil {
+					t.Fatal(err)
 				}
 
 				comparePaginations(t, test.pageRequest, test.wantPage)
+
+				if !slices.Equal(test.wantCodes, codes) {
+					t.Errorf("codes = %v, want %v", codes, test.wantCodes)
+				}
 			}
 			{
-				tx := db.Clauses(test.cursorRequest).Joins("Price").Find(&[]*TestProduct{})
-				if tx.Error != nil {
-					t.Fatal(tx.Error)
+				if _, err := test.find(db.Clauses(test.cursorRequest)); err != nil {
+					t.Fatal(err)
 				}
 
 				comparePaginations(t, test.cursorRequest, test.wantCursor)
@@ -760,6 +1011,57 @@ func TestWithJoins(t *testing.T) {
 	}
 }
 
+func TestHasToManyJoins(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+
+	tests := map[string]struct {
+		joins []string
+		want  bool
+	}{
+		"no joins": {
+			joins: nil,
+			want:  false,
+		},
+		"has many relationship": {
+			joins: []string{"Items"},
+			want:  true,
+		},
+		"many to many raw join": {
+			joins: []string{"LEFT JOIN test_order_tags ON test_order_tags.test_order_id = test_orders.id"},
+			want:  true,
+		},
+		"quoted has many raw join": {
+			joins: []string{"join `test_order_items` ON `test_order_items`.`test_order_id` = `test_orders`.`id`"},
+			want:  true,
+		},
+		"raw join of other table": {
+			joins: []string{"JOIN test_order_items_archive ON test_order_items_archive.test_order_id = test_orders.id"},
+			want:  false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tx := db.Model(&TestOrder{})
+			for _, join := range test.joins {
+				tx = tx.Joins(join)
+			}
+
+			if err := tx.Statement.Parse(&TestOrder{}); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := hasToManyJoins(tx.Statement); got != test.want {
+				t.Errorf("hasToManyJoins() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
 func TestWithJoinsWhereClause(t *testing.T) {
 	t.Parallel()
 
@@ -1274,6 +1576,398 @@ func TestContextCancelledAfterPagorminator(t *testing.T) {
 	comparePaginations(t, pageRequest, want)
 }
 
+func TestSortByField(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		toMigrate   []*TestStruct
+		pageRequest *pagepagination.Pagination
+		wantPage    *wantPagePagination
+		wantResult  []*TestStruct
+	}{
+		"Page 0/0, size 5, sort by Price desc": {
+			toMigrate: []*TestStruct{
+				{Code: "1", Price: 1}, {Code: "2", Price: 3}, {Code: "3", Price: 2},
+			},
+			pageRequest: pagepagination.Must(0, 5, pagegeneric.DescField("Price")),
+			wantPage: &wantPagePagination{
+				page:             0,
+				size:             5,
+				sort:             []pagegeneric.Order{pagegeneric.DescField("Price")},
+				totalElements:    3,
+				totalElementsSet: true,
+			},
+			wantResult: []*TestStruct{
+				{Code: "2", Price: 3}, {Code: "3", Price: 2}, {Code: "1", Price: 1},
+			},
+		},
+		"Page 1/1, size 2, sort by Code asc and Price desc, mixing column and field orders": {
+			toMigrate: []*TestStruct{
+				{Code: "1", Price: 1}, {Code: "2", Price: 2}, {Code: "1", Price: 11}, {Code: "3", Price: 3},
+			},
+			pageRequest: pagepagination.Must(1, 2, pagegeneric.Asc("code"), pagegeneric.DescField("Price")),
+			wantPage: &wantPagePagination{
+				page:             1,
+				size:             2,
+				sort:             []pagegeneric.Order{pagegeneric.Asc("code"), pagegeneric.DescField("Price")},
+				totalElements:    4,
+				totalElementsSet: true,
+			},
+			wantResult: []*TestStruct{
+				{Code: "2", Price: 2}, {Code: "3", Price: 3},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db := setupDB(t)
+
+			txCreate := db.CreateInBatches(&test.toMigrate, len(test.toMigrate))
+			if txCreate.Error != nil {
+				t.Fatal(txCreate.Error)
+			}
+
+			var gotResult []*TestStruct
+			if tx := db.Clauses(test.pageRequest).Find(&gotResult); tx.Error != nil {
+				t.Fatal(tx.Error)
+			}
+
+			comparePaginations(t, test.pageRequest, test.wantPage)
+			compareTestStructs(t, gotResult, test.wantResult)
+		})
+	}
+}
+
+func TestSortByFieldWithJoins(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	toMigrate := []*TestProduct{
+		{Code: "A", Price: TestPrice{Amount: 1, Currency: "EUR"}},
+		{Code: "B", Price: TestPrice{Amount: 2, Currency: "EUR"}},
+		{Code: "C", Price: TestPrice{Amount: 3, Currency: "EUR"}},
+	}
+
+	if txCreate := db.CreateInBatches(&toMigrate, len(toMigrate)); txCreate.Error != nil {
+		t.Fatal(txCreate.Error)
+	}
+
+	// both tables have an id column, so the resolved column must be qualified with the model table.
+	pageRequest := pagepagination.Must(0, 2, pagegeneric.DescField("ID"))
+
+	var products []*TestProduct
+	if tx := db.Clauses(pageRequest).Joins("Price").Find(&products); tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+
+	if len(products) != 2 || products[0].Code != "C" || products[1].Code != "B" {
+		t.Errorf("unexpected result: %+v", products)
+	}
+}
+
+func TestSortByEmbeddedField(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	toMigrate := []*TestEmbeddedProduct{
+		{Code: "A", Price: TestMoney{Amount: 2, Currency: "EUR"}},
+		{Code: "B", Price: TestMoney{Amount: 1, Currency: "USD"}},
+		{Code: "C", Price: TestMoney{Amount: 3, Currency: "EUR"}},
+	}
+
+	if txCreate := db.CreateInBatches(&toMigrate, len(toMigrate)); txCreate.Error != nil {
+		t.Fatal(txCreate.Error)
+	}
+
+	pageRequest := pagepagination.Must(0, 2, pagegeneric.AscField("Amount"))
+
+	var products []*TestEmbeddedProduct
+	if tx := db.Clauses(pageRequest).Find(&products); tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+
+	if len(products) != 2 || products[0].Code != "B" || products[1].Code != "A" {
+		t.Errorf("unexpected result: %+v", products)
+	}
+
+	wantPage := &wantPagePagination{
+		page:             0,
+		size:             2,
+		sort:             []pagegeneric.Order{pagegeneric.AscField("Amount")},
+		totalElements:    3,
+		totalElementsSet: true,
+	}
+	comparePaginations(t, pageRequest, wantPage)
+}
+
+func TestDefaultSort(t *testing.T) {
+	t.Parallel()
+
+	toMigrate := func() []*TestDefaultSortStruct {
+		return []*TestDefaultSortStruct{
+			{Model: gorm.Model{ID: 1}, Code: "B", Price: 1},
+			{Model: gorm.Model{ID: 2}, Code: "A", Price: 2},
+			{Model: gorm.Model{ID: 3}, Code: "A", Price: 1},
+			{Model: gorm.Model{ID: 4}, Code: "B", Price: 2},
+		}
+	}
+
+	tests := map[string]struct {
+		pagination Pagination
+		wantIDs    []uint
+	}{
+		"page without sort, default sort applied": {
+			pagination: pagepagination.Must(0, 4),
+			wantIDs:    []uint{2, 3, 4, 1},
+		},
+		"page sorted by price, default sort appended": {
+			pagination: pagepagination.Must(0, 4, pagegeneric.Desc("price")),
+			wantIDs:    []uint{2, 4, 3, 1},
+		},
+		"page sorted by code, duplicated column removed from default sort": {
+			pagination: pagepagination.Must(0, 4, pagegeneric.Desc("code")),
+			wantIDs:    []uint{4, 1, 2, 3},
+		},
+		"page sorted by field, duplicated column removed from default sort": {
+			pagination: pagepagination.Must(0, 4, pagegeneric.DescField("Code")),
+			wantIDs:    []uint{4, 1, 2, 3},
+		},
+		"cursor sorted by price, default sort appended": {
+			pagination: cursorpagination.Must(4, cursorpagination.Asc("price", nil)),
+			wantIDs:    []uint{3, 1, 2, 4},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db := setupDB(t)
+
+			data := toMigrate()
+			if txCreate := db.CreateInBatches(&data, len(data)); txCreate.Error != nil {
+				t.Fatal(txCreate.Error)
+			}
+
+			var got []*TestDefaultSortStruct
+			if tx := db.Clauses(test.pagination).Find(&got); tx.Error != nil {
+				t.Fatal(tx.Error)
+			}
+
+			gotIDs := make([]uint, len(got))
+			for i, item := range got {
+				gotIDs[i] = item.ID
+			}
+
+			if diff := cmp.Diff(test.wantIDs, gotIDs); diff != "" {
+				t.Errorf("diff (-want +got):\n%s", diff)
+			}
+
+			if totalElements, _ := test.pagination.TotalElements(); totalElements != 4 {
+				t.Errorf("TotalElements() = %d, want %d", totalElements, 4)
+			}
+		})
+	}
+}
+
+func TestRandomSort(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+
+	toMigrate := make([]*TestStruct, 20)
+	for i := range toMigrate {
+		toMigrate[i] = &TestStruct{Code: strconv.Itoa(i), Price: uint(i)}
+	}
+
+	if txCreate := db.CreateInBatches(&toMigrate, len(toMigrate)); txCreate.Error != nil {
+		t.Fatal(txCreate.Error)
+	}
+
+	const seed = 42
+
+	var pageIDs []uint
+
+	pageRequest := pagepagination.Must(0, 6, pagegeneric.NewRandom("id", seed))
+	for hasNext := pagegeneric.PrevNextPossible(true); hasNext; pageRequest, hasNext = pageRequest.Next() {
+		var products []*TestStruct
+		if tx := db.Clauses(pageRequest).Find(&products); tx.Error != nil {
+			t.Fatal(tx.Error)
+		}
+
+		for _, product := range products {
+			pageIDs = append(pageIDs, product.ID)
+		}
+	}
+
+	var cursorIDs []uint
+
+	cursorRequest := cursorpagination.Must(6, cursorpagination.Random("id", seed, nil))
+	for hasNext := pagegeneric.PrevNextPossible(true); hasNext; cursorRequest, hasNext = cursorRequest.Next() {
+		var products []*TestStruct
+		if tx := db.Clauses(cursorRequest).Find(&products); tx.Error != nil {
+			t.Fatal(tx.Error)
+		}
+
+		for _, product := range products {
+			cursorIDs = append(cursorIDs, product.ID)
+		}
+	}
+
+	if diff := cmp.Diff(pageIDs, cursorIDs); diff != "" {
+		t.Errorf("page and cursor orders differ (-page +cursor):\n%s", diff)
+	}
+
+	sortedIDs := slices.Sorted(slices.Values(pageIDs))
+	if len(slices.Compact(sortedIDs)) != len(toMigrate) {
+		t.Errorf("pages overlap or miss rows: %v", pageIDs)
+	}
+
+	if slices.IsSorted(pageIDs) {
+		t.Errorf("rows are not shuffled: %v", pageIDs)
+	}
+
+	var otherSeedIDs []uint
+	if tx := db.Clauses(pagepagination.Must(0, 20, pagegeneric.NewRandom("id", seed+1))).
+		Model(&TestStruct{}).Pluck("id", &otherSeedIDs); tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+
+	if slices.Equal(pageIDs, otherSeedIDs) {
+		t.Errorf("different seeds produce the same order: %v", pageIDs)
+	}
+}
+
+func TestValueListSort(t *testing.T) {
+	t.Parallel()
+
+	toMigrate := func() []*TestStruct {
+		return []*TestStruct{
+			{Model: gorm.Model{ID: 1}, Code: "normal", Price: 1},
+			{Model: gorm.Model{ID: 2}, Code: "urgent", Price: 2},
+			{Model: gorm.Model{ID: 3}, Code: "low", Price: 3},
+			{Model: gorm.Model{ID: 4}, Code: "high", Price: 4},
+			{Model: gorm.Model{ID: 5}, Code: "urgent", Price: 5},
+			{Model: gorm.Model{ID: 6}, Code: "unknown", Price: 6},
+		}
+	}
+	priorities := []any{"urgent", "high", "normal", "low"}
+	want := [][]uint{{2, 5}, {4, 1}, {3, 6}}
+
+	t.Run("page pagination", func(t *testing.T) {
+		t.Parallel()
+
+		db := setupDB(t)
+
+		data := toMigrate()
+		if txCreate := db.CreateInBatches(&data, len(data)); txCreate.Error != nil {
+			t.Fatal(txCreate.Error)
+		}
+
+		for page, wantIDs := range want {
+			pageRequest := pagepagination.Must(
+				page, 2, pagegeneric.NewValueList("code", priorities...), pagegeneric.Asc("id"),
+			)
+
+			var gotIDs []uint
+			if tx := db.Clauses(pageRequest).Where("price > ?", 0).Model(&TestStruct{}).
+				Pluck("id", &gotIDs); tx.Error != nil {
+				t.Fatal(tx.Error)
+			}
+
+			if diff := cmp.Diff(wantIDs, gotIDs); diff != "" {
+				t.Errorf("page %d diff (-want +got):\n%s", page, diff)
+			}
+
+			if totalElements, _ := pageRequest.TotalElements(); totalElements != 6 {
+				t.Errorf("TotalElements() = %d, want %d", totalElements, 6)
+			}
+		}
+	})
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		t.Parallel()
+
+		db := setupDB(t)
+
+		data := toMigrate()
+		if txCreate := db.CreateInBatches(&data, len(data)); txCreate.Error != nil {
+			t.Fatal(txCreate.Error)
+		}
+
+		cursorRequest := cursorpagination.Must(
+			2, cursorpagination.ValueList("code", priorities, nil), cursorpagination.Asc("id", nil),
+		)
+
+		for _, wantIDs := range want {
+			var products []*TestStruct
+			if tx := db.Clauses(cursorRequest).Where("price > ?", 0).Find(&products); tx.Error != nil {
+				t.Fatal(tx.Error)
+			}
+
+			gotIDs := make([]uint, len(products))
+			for i, product := range products {
+				gotIDs[i] = product.ID
+			}
+
+			if diff := cmp.Diff(wantIDs, gotIDs); diff != "" {
+				t.Errorf("diff (-want +got):\n%s", diff)
+			}
+
+			if totalElements, _ := cursorRequest.TotalElements(); totalElements != 6 {
+				t.Errorf("TotalElements() = %d, want %d", totalElements, 6)
+			}
+
+			cursorRequest, _ = cursorRequest.Next()
+		}
+	})
+}
+
+func TestIDPagination(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	toMigrate := []*TestStruct{
+		{Model: gorm.Model{ID: 1}, Code: "A", Price: 1},
+		{Model: gorm.Model{ID: 2}, Code: "B", Price: 2},
+		{Model: gorm.Model{ID: 3}, Code: "C", Price: 3},
+		{Model: gorm.Model{ID: 4}, Code: "D", Price: 4},
+		{Model: gorm.Model{ID: 5}, Code: "E", Price: 5},
+	}
+
+	if txCreate := db.CreateInBatches(&toMigrate, len(toMigrate)); txCreate.Error != nil {
+		t.Fatal(txCreate.Error)
+	}
+
+	want := [][]string{{"D", "B"}, {"E", "A"}, {"C"}}
+
+	pagination := idpagination.Must(0, 2, "id", 4, 2, 5, 1, 3)
+	for page := 0; pagination != nil; page++ {
+		var products []*TestStruct
+		if tx := db.Clauses(pagination).Find(&products); tx.Error != nil {
+			t.Fatal(tx.Error)
+		}
+
+		gotCodes := make([]string, len(products))
+		for i, product := range products {
+			gotCodes[i] = product.Code
+		}
+
+		if diff := cmp.Diff(want[page], gotCodes); diff != "" {
+			t.Errorf("page %d diff (-want +got):\n%s", page, diff)
+		}
+
+		if totalElements, _ := pagination.TotalElements(); totalElements != 5 || pagination.TotalPages() != 3 {
+			t.Errorf("TotalElements() = %d, TotalPages() = %d, want 5, 3", totalElements, pagination.TotalPages())
+		}
+
+		pagination, _ = pagination.Next()
+	}
+}
+
 func TestCursorPaginationSingleColumn(t *testing.T) {
 	t.Parallel()
 
@@ -1462,7 +2156,177 @@ func TestCursorPaginationTotalElementsIgnoreCursorWhere(t *testing.T) {
 	comparePaginations(t, pageRequest, wantPage)
 }
 
-func setupDB(t *testing.T) *gorm.DB {
+func TestCursorPaginationTotalElementsIgnoreGroupedCursorCondition(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	createdAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	toMigrate := []*TestStruct{
+		{Model: gorm.Model{ID: 1, CreatedAt: createdAt}, Code: "A", Price: 1},
+		{Model: gorm.Model{ID: 2, CreatedAt: createdAt}, Code: "B", Price: 100},
+		{Model: gorm.Model{ID: 3, CreatedAt: createdAt.Add(time.Hour)}, Code: "C", Price: 100},
+		{Model: gorm.Model{ID: 4, CreatedAt: createdAt.Add(2 * time.Hour)}, Code: "D", Price: 200},
+	}
+
+	if txCreate := db.CreateInBatches(&toMigrate, len(toMigrate)); txCreate.Error != nil {
+		t.Fatal(txCreate.Error)
+	}
+
+	// groups the where conditions, as scopes rewriting the where clause do.
+	groupWhere := func(db *gorm.DB) *gorm.DB {
+		if where, ok := db.Statement.Clauses["WHERE"].Expression.(clause.Where); ok {
+			db.Statement.Clauses["WHERE"] = clause.Clause{
+				Name:       "WHERE",
+				Expression: clause.Where{Exprs: []clause.Expression{clause.AndConditions{Exprs: where.Exprs}}},
+			}
+		}
+
+		return db
+	}
+
+	pageRequest := cursorpagination.Must(1,
+		cursorpagination.Asc("created_at", createdAt), cursorpagination.Asc("id", 2))
+
+	var products []*TestStruct
+	if tx := db.Clauses(pageRequest).Where("price > 50").Scopes(groupWhere).Find(&products); tx.Error != nil {
+		t.Fatal(tx.Error)
+	}
+
+	if len(products) != 1 || products[0].Code != "C" {
+		t.Errorf("unexpected result: %+v", products)
+	}
+
+	if total, _ := pageRequest.TotalElements(); total != 3 {
+		t.Errorf("expected 3 total elements, got %d", total)
+	}
+}
+
+func TestCursorPaginationQualifiedColumnWithJoins(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+
+	products := []*TestProduct{
+		{Code: "1", Price: TestPrice{Amount: 1, Currency: "EUR"}},
+		{Code: "2", Price: TestPrice{Amount: 2, Currency: "EUR"}},
+		{Code: "3", Price: TestPrice{Amount: 3, Currency: "EUR"}},
+	}
+	if err := db.Create(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	pageRequest := cursorpagination.Must(2, cursorpagination.Asc("test_products.id", nil))
+
+	var codes []string
+
+	for pageRequest != nil {
+		var page []*TestProduct
+		if err := db.Clauses(pageRequest).Joins("Price").Find(&page).Error; err != nil {
+			t.Fatal(err)
+		}
+
+		if err := pageRequest.Err(); err != nil {
+			t.Fatalf("unexpected cursor error: %v", err)
+		}
+
+		for _, product := range page {
+			codes = append(codes, product.Code)
+		}
+
+		next, ok := pageRequest.Next()
+		if !ok {
+			break
+		}
+
+		pageRequest = next
+	}
+
+	if diff := cmp.Diff([]string{"1", "2", "3"}, codes); diff != "" {
+		t.Errorf("codes mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCursorPaginationErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		strict  bool
+		column  string
+		query   func(db *gorm.DB, pagination *cursorpagination.Pagination) *gorm.DB
+		wantErr error
+	}{
+		"cursor column not in schema": {
+			column: "LOWER(code)",
+			query: func(db *gorm.DB, pagination *cursorpagination.Pagination) *gorm.DB {
+				var products []*TestStruct
+
+				return db.Clauses(pagination).Find(&products)
+			},
+			wantErr: CursorColumnNotInSchemaError{Column: "LOWER(code)", Table: "test_structs"},
+		},
+		"unsupported destination": {
+			column: "price",
+			query: func(db *gorm.DB, pagination *cursorpagination.Pagination) *gorm.DB {
+				var codes []string
+
+				return db.Model(&TestStruct{}).Clauses(pagination).Pluck("code", &codes)
+			},
+			wantErr: UnsupportedDestinationError{Type: reflect.TypeFor[*[]string]()},
+		},
+		"strict, cursor column not in schema": {
+			strict: true,
+			column: "LOWER(code)",
+			query: func(db *gorm.DB, pagination *cursorpagination.Pagination) *gorm.DB {
+				var products []*TestStruct
+
+				return db.Clauses(pagination).Find(&products)
+			},
+			wantErr: CursorColumnNotInSchemaError{Column: "LOWER(code)", Table: "test_structs"},
+		},
+		"strict, unsupported destination": {
+			strict: true,
+			column: "price",
+			query: func(db *gorm.DB, pagination *cursorpagination.Pagination) *gorm.DB {
+				var codes []string
+
+				return db.Model(&TestStruct{}).Clauses(pagination).Pluck("code", &codes)
+			},
+			wantErr: UnsupportedDestinationError{Type: reflect.TypeFor[*[]string]()},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db := setupDBWithPlugin(t, PaGorminator{Strict: test.strict})
+			migrateTestStructs(t, db, 3)
+
+			pagination := cursorpagination.Must(2, cursorpagination.Asc(test.column, nil))
+
+			tx := test.query(db, pagination)
+
+			var wantDBErr error
+			if test.strict {
+				wantDBErr = test.wantErr
+			}
+
+			if diff := cmp.Diff(wantDBErr, tx.Error, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("db error mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(test.wantErr, pagination.Err(), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("pagination error mismatch (-want +got):\n%s", diff)
+			}
+
+			if _, ok := pagination.Next(); ok {
+				t.Error("expected no next page")
+			}
+		})
+	}
+}
+
+func setupDBWithPlugin(t *testing.T, plugin PaGorminator) *gorm.DB {
 	t.Helper()
 
 	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
@@ -1471,19 +2335,25 @@ func setupDB(t *testing.T) *gorm.DB {
 	}
 
 	// Migrate the schema
-	err = db.AutoMigrate(&TestStruct{}, &TestProduct{}, &TestPrice{})
+	err = db.AutoMigrate(&TestStruct{}, &TestProduct{}, &TestPrice{}, &TestEmbeddedProduct{}, &TestDefaultSortStruct{},
+		&TestOrder{}, &TestOrderItem{}, &TestTag{}, &TestShipment{}, &TestParcel{})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	err = db.Use(PaGorminator{Debug: true})
-	if err != nil {
+	if err = db.Use(plugin); err != nil {
 		t.Fatal(err)
 	}
 
 	return db
 }
 
+func setupDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	return setupDBWithPlugin(t, PaGorminator{Debug: true})
+}
+
 func toExpectedPagination(got Pagination) any {
 	if got == nil {
 		return nil
@@ -1560,6 +2430,59 @@ type (
 		TestProductID uint
 	}
 
+	TestEmbeddedProduct struct {
+		gorm.Model
+
+		Code  string
+		Price TestMoney `gorm:"embedded;embeddedPrefix:price_"`
+	}
+
+	TestMoney struct {
+		Amount   uint
+		Currency string
+	}
+
+	TestDefaultSortStruct struct {
+		gorm.Model
+
+		Code  string
+		Price uint
+	}
+
+	TestOrder struct {
+		gorm.Model
+
+		Code  string
+		Items []TestOrderItem
+		Tags  []TestTag `gorm:"many2many:test_order_tags"`
+	}
+
+	TestOrderItem struct {
+		gorm.Model
+
+		Name        string
+		TestOrderID uint
+	}
+
+	TestTag struct {
+		gorm.Model
+
+		Name string
+	}
+
+	TestShipment struct {
+		Carrier string `gorm:"primaryKey"`
+		Code    string `gorm:"primaryKey"`
+		Parcels []TestParcel
+	}
+
+	TestParcel struct {
+		ID                  uint
+		Name                string
+		TestShipmentCarrier string
+		TestShipmentCode    string
+	}
+
 	wantPagePagination struct {
 		page             int
 		size             int
@@ -1576,6 +2499,10 @@ type (
 	}
 )
 
+func (TestDefaultSortStruct) DefaultSort() pagegeneric.Sort {
+	return pagegeneric.NewSort(pagegeneric.AscField("Code"), pagegeneric.Desc("price"), pagegeneric.Asc("id"))
+}
+
 func compareTestStructs(t *testing.T, got, want []*TestStruct) {
 	t.Helper()
 
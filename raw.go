@@ -0,0 +1,107 @@
+package pagorminator
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// rawAlias is the alias of the raw SQL subquery when the statement has no table.
+const rawAlias = "pagorminator_raw"
+
+// isRawStatement returns true if the statement SQL was set with Raw, so it is not built from its clauses.
+func isRawStatement(stmt *gorm.Statement) bool {
+	return stmt.SQL.Len() > 0
+}
+
+// paginateRaw paginates the statements whose SQL was set with Raw, e.g. db.Clauses(pageRequest).Raw(sql).Scan(&rows).
+// The total elements are counted wrapping the raw SQL as a subquery, and the pagination clauses are applied to it.
+func (p PaGorminator) paginateRaw(db *gorm.DB) {
+	if !isRawStatement(db.Statement) {
+		return
+	}
+
+	pageable, ok := p.getPageRequest(db)
+	if !ok {
+		return
+	}
+
+	p.reportConflicts(db, pageable)
+
+	if db.Error != nil {
+		return
+	}
+
+	p.observe(db.Statement.Context, pageAppliedEvent(db.Statement.Table, pageable))
+	p.applySort(db, pageable)
+
+	if !p.skipCount(db, pageable) {
+		duration, err := p.countRawTotalElements(db, pageable)
+		p.logPage(db, pageable, CountStatusCounted, duration, err)
+
+		if err != nil {
+			_ = db.AddError(err)
+
+			return
+		}
+	}
+
+	appendRawClauses(db.Statement)
+}
+
+// countRawTotalElements counts the rows of the raw SQL in a new session, and sets them in the pagination.
+// It returns the count duration.
+func (p PaGorminator) countRawTotalElements(db *gorm.DB, pageable Pagination) (time.Duration, error) {
+	tx := db.Session(&gorm.Session{Context: db.Statement.Context})
+	if p.Debug {
+		tx = tx.Debug()
+	}
+
+	countSQL := "SELECT COUNT(*) FROM (" + trimRawSQL(db.Statement.SQL.String()) + ") AS " + rawAlias
+	tx.Statement.SQL.Reset()
+	tx.Statement.SQL.WriteString(countSQL)
+
+	return p.runCount(db, pageable, func(totalElements *int64) (string, error) {
+		err := p.countOnReplica(db, tx.Set(countKey, true), func(tx *gorm.DB) error {
+			return tx.Scan(totalElements).Error
+		})
+
+		return countSQL, err
+	})
+}
+
+// appendRawClauses appends the pagination clauses to the raw SQL, built with the dialect clause builders.
+// The raw SQL is always wrapped as a subquery, aliased as the statement table, so the clauses don't conflict
+// with its own clauses, e.g. its limit or a union, and the cursor conditions and the sort apply to its columns.
+func appendRawClauses(stmt *gorm.Statement) {
+	clauses := make([]string, 0, 3)
+	for _, name := range []string{"WHERE", "ORDER BY", "LIMIT"} {
+		if _, ok := stmt.Clauses[name]; ok {
+			clauses = append(clauses, name)
+		}
+	}
+
+	if len(clauses) == 0 {
+		return
+	}
+
+	alias := stmt.Table
+	if alias == "" {
+		alias = rawAlias
+	}
+
+	rawSQL := trimRawSQL(stmt.SQL.String())
+	stmt.SQL.Reset()
+	stmt.SQL.WriteString("SELECT * FROM (")
+	stmt.SQL.WriteString(rawSQL)
+	stmt.SQL.WriteString(") AS ")
+	stmt.WriteQuoted(alias)
+	stmt.SQL.WriteByte(' ')
+	stmt.Build(clauses...)
+}
+
+// trimRawSQL removes the trailing spaces and semicolons of the raw SQL, so it can be wrapped.
+func trimRawSQL(rawSQL string) string {
+	return strings.TrimRight(rawSQL, " \t\r\n;")
+}
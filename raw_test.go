@@ -0,0 +1,174 @@
+package pagorminator
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/idpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+type rawTestRow struct {
+	Code  string
+	Price uint
+}
+
+func TestRawScan(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	migrateTestStructs(t, db, 5)
+
+	tests := map[string]struct {
+		pagination Pagination
+		sql        string
+		vars       []any
+		countMode  CountMode
+		want       []rawTestRow
+		wantTotal  int64
+		wantSet    bool
+	}{
+		"page pagination keeps the raw order": {
+			pagination: pagepagination.Must(0, 2),
+			sql:        "SELECT code, price FROM test_structs WHERE price > ? ORDER BY price DESC",
+			vars:       []any{1},
+			want:       []rawTestRow{{Code: "5", Price: 5}, {Code: "4", Price: 4}},
+			wantTotal:  4,
+			wantSet:    true,
+		},
+		"page pagination with sort and named params": {
+			pagination: pagepagination.Must(1, 2, pagegeneric.Desc("price")),
+			sql:        "SELECT code, price FROM test_structs WHERE price > @min AND price < @max;",
+			vars:       []any{sql.Named("min", 0), sql.Named("max", 5)},
+			want:       []rawTestRow{{Code: "2", Price: 2}, {Code: "1", Price: 1}},
+			wantTotal:  4,
+			wantSet:    true,
+		},
+		"page pagination of raw SQL with its own limit": {
+			pagination: pagepagination.Must(1, 2),
+			sql:        "SELECT code, price FROM test_structs ORDER BY price LIMIT 3",
+			want:       []rawTestRow{{Code: "3", Price: 3}},
+			wantTotal:  3,
+			wantSet:    true,
+		},
+		"page pagination of a raw union": {
+			pagination: pagepagination.Must(0, 2, pagegeneric.Desc("price")),
+			sql: "SELECT code, price FROM test_structs WHERE price < 2 " +
+				"UNION SELECT code, price FROM test_structs WHERE price > 4",
+			want:      []rawTestRow{{Code: "5", Price: 5}, {Code: "1", Price: 1}},
+			wantTotal: 2,
+			wantSet:   true,
+		},
+		"page pagination with count skipped": {
+			pagination: pagepagination.Must(2, 2, pagegeneric.Asc("price")),
+			sql:        "SELECT code, price FROM test_structs",
+			countMode:  CountSkip,
+			want:       []rawTestRow{{Code: "5", Price: 5}},
+		},
+		"cursor pagination with cursor values": {
+			pagination: cursorpagination.Must(2, cursorpagination.Asc("price", 2)),
+			sql:        "SELECT code, price FROM test_structs",
+			want:       []rawTestRow{{Code: "3", Price: 3}, {Code: "4", Price: 4}},
+			wantTotal:  5,
+			wantSet:    true,
+		},
+		"id pagination": {
+			pagination: idpagination.Must(0, 2, "price", 4, 1, 3),
+			sql:        "SELECT code, price FROM test_structs",
+			want:       []rawTestRow{{Code: "4", Price: 4}, {Code: "1", Price: 1}},
+			wantTotal:  3,
+			wantSet:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var rows []rawTestRow
+
+			tx := db.Set(CountModeKey, test.countMode).Clauses(test.pagination).Raw(test.sql, test.vars...).Scan(&rows)
+			if tx.Error != nil {
+				t.Fatal(tx.Error)
+			}
+
+			if diff := cmp.Diff(test.want, rows); diff != "" {
+				t.Errorf("rows mismatch (-want +got):\n%s", diff)
+			}
+
+			total, set := test.pagination.TotalElements()
+			if total != test.wantTotal || set != test.wantSet {
+				t.Errorf("expected total elements %d, set %t, got %d, %t", test.wantTotal, test.wantSet, total, set)
+			}
+		})
+	}
+}
+
+func TestRawFindCursorNext(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	migrateTestStructs(t, db, 5)
+
+	pageRequest := cursorpagination.Must(2, cursorpagination.Desc("price", nil))
+	rawSQL := "SELECT * FROM test_structs WHERE price < @max"
+
+	var codes []string
+
+	for pageRequest != nil {
+		var products []*TestStruct
+		if err := db.Clauses(pageRequest).Raw(rawSQL, sql.Named("max", 5)).Find(&products).Error; err != nil {
+			t.Fatal(err)
+		}
+
+		if total, _ := pageRequest.TotalElements(); total != 4 {
+			t.Errorf("expected 4 total elements, got %d", total)
+		}
+
+		for _, product := range products {
+			codes = append(codes, product.Code)
+		}
+
+		next, ok := pageRequest.Next()
+		if !ok {
+			break
+		}
+
+		pageRequest = next
+	}
+
+	if diff := cmp.Diff([]string{"4", "3", "2", "1"}, codes); diff != "" {
+		t.Errorf("codes mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRawScanCursorNext(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	migrateTestStructs(t, db, 5)
+
+	pageRequest := cursorpagination.Must(2, cursorpagination.Asc("price", nil))
+
+	var rows []rawTestRow
+	if err := db.Clauses(pageRequest).Raw("SELECT code, price FROM test_structs").Scan(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]rawTestRow{{Code: "1", Price: 1}, {Code: "2", Price: 2}}, rows); diff != "" {
+		t.Errorf("rows mismatch (-want +got):\n%s", diff)
+	}
+
+	if next, ok := pageRequest.Next(); next != nil || ok != pagegeneric.PreviousCursorValuesNotSet {
+		t.Errorf("expected no next page with the cursor values not set, got %v, %v", next, ok)
+	}
+
+	if err := pageRequest.Err(); !errors.Is(err, ErrCursorValuesNotCaptured) {
+		t.Errorf("expected error %v, got %v", ErrCursorValuesNotCaptured, err)
+	}
+}
@@ -0,0 +1,239 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/cursorpagination"
+	"github.com/manuelarte/pagorminator/pagegeneric"
+)
+
+type (
+	// Args are the connection arguments, nil when not set.
+	Args struct {
+		First  *int
+		After  *string
+		Last   *int
+		Before *string
+	}
+
+	// Connection is the page of nodes with their cursors.
+	//
+	//go:structinit
+	Connection[T any] struct {
+		Edges      []Edge[T] `json:"edges"`
+		PageInfo   PageInfo  `json:"pageInfo"`
+		TotalCount int64     `json:"totalCount"`
+	}
+
+	// Edge is a node with its cursor.
+	//
+	//go:structinit
+	Edge[T any] struct {
+		Node   T      `json:"node"`
+		Cursor string `json:"cursor"`
+	}
+
+	// PageInfo is the information about the connection page.
+	//
+	//go:structinit
+	PageInfo struct {
+		HasNextPage     bool    `json:"hasNextPage"`
+		HasPreviousPage bool    `json:"hasPreviousPage"`
+		StartCursor     *string `json:"startCursor"`
+		EndCursor       *string `json:"endCursor"`
+	}
+)
+
+// Find runs the query with the connection arguments and returns the connection.
+// The cursors define the order of the nodes, their values are taken from after or before,
+// and each edge cursor is computed from the node cursor columns values.
+// When paginating backwards with last and before, the cursor orders are reversed, so they must be Asc or Desc.
+//
+// As the specification allows, hasPreviousPage is true when paginating forwards with after,
+// and hasNextPage is true when paginating backwards with before.
+//
+// Errors:
+//   - ErrFirstOrLastRequired, ErrFirstAndLast, ErrCountCantBeNegative or ErrCursorDirectionNotValid
+//     if the arguments are not valid.
+//   - ErrOrderNotReversible if paginating backwards with a cursor order that is not Asc or Desc.
+//   - ErrCursorColumnNotFound if a cursor column is not a field of T.
+//   - Any error returned by cursorpagination.FromToken or pagorminator.Find.
+func Find[T any](ctx context.Context, db *gorm.DB, args Args, cursors ...cursorpagination.Cursor) (Connection[T], error) {
+	forward, count, token, err := args.direction()
+	if err != nil {
+		return Connection[T]{}, err
+	}
+
+	if !forward {
+		if cursors, err = reverse(cursors); err != nil {
+			return Connection[T]{}, err
+		}
+	}
+
+	// one more node than requested to know whether there are more nodes.
+	pagination, err := cursorpagination.FromToken(count+1, token, cursors...)
+	if err != nil {
+		return Connection[T]{}, err
+	}
+
+	page, err := pagorminator.Find[T](ctx, db, pagination)
+	if columnErr := (pagorminator.CursorColumnNotInSchemaError{}); errors.As(err, &columnErr) {
+		return Connection[T]{}, fmt.Errorf("%w: %q", ErrCursorColumnNotFound, columnErr.Column)
+	}
+
+	if err != nil {
+		return Connection[T]{}, err
+	}
+
+	nodes := page.Content
+	hasMore := len(nodes) > count
+	nodes = nodes[:min(len(nodes), count)]
+
+	if !forward {
+		slices.Reverse(nodes)
+	}
+
+	edges, err := newEdges(ctx, db, nodes, cursors)
+	if err != nil {
+		return Connection[T]{}, err
+	}
+
+	pageInfo := PageInfo{
+		HasNextPage:     hasMore,
+		HasPreviousPage: token != "",
+		StartCursor:     nil,
+		EndCursor:       nil,
+	}
+	if !forward {
+		pageInfo.HasNextPage, pageInfo.HasPreviousPage = pageInfo.HasPreviousPage, pageInfo.HasNextPage
+	}
+
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return Connection[T]{Edges: edges, PageInfo: pageInfo, TotalCount: page.TotalElements}, nil
+}
+
+// direction returns whether the pagination is forwards, the number of nodes and the cursor token.
+func (a Args) direction() (bool, int, string, error) {
+	switch {
+	case a.First != nil && a.Last != nil:
+		return false, 0, "", ErrFirstAndLast
+	case a.First != nil:
+		if *a.First < 0 {
+			return false, 0, "", ErrCountCantBeNegative
+		}
+
+		if a.Before != nil {
+			return false, 0, "", ErrCursorDirectionNotValid
+		}
+
+		return true, *a.First, valueOrEmpty(a.After), nil
+	case a.Last != nil:
+		if *a.Last < 0 {
+			return false, 0, "", ErrCountCantBeNegative
+		}
+
+		if a.After != nil {
+			return false, 0, "", ErrCursorDirectionNotValid
+		}
+
+		return false, *a.Last, valueOrEmpty(a.Before), nil
+	default:
+		return false, 0, "", ErrFirstOrLastRequired
+	}
+}
+
+// reverse returns the cursors with the orders reversed.
+func reverse(cursors []cursorpagination.Cursor) ([]cursorpagination.Cursor, error) {
+	reversed := make([]cursorpagination.Cursor, len(cursors))
+	for i, cursor := range cursors {
+		switch cursor.Order().(type) {
+		case pagegeneric.Asc:
+			reversed[i] = cursorpagination.Desc(cursor.Column(), cursor.Value())
+		case pagegeneric.Desc:
+			reversed[i] = cursorpagination.Asc(cursor.Column(), cursor.Value())
+		default:
+			return nil, fmt.Errorf("%w: %q", ErrOrderNotReversible, cursor.Column())
+		}
+	}
+
+	return reversed, nil
+}
+
+// newEdges creates the edges with the cursor token of each node.
+func newEdges[T any](
+	ctx context.Context,
+	db *gorm.DB,
+	nodes []T,
+	cursors []cursorpagination.Cursor,
+) ([]Edge[T], error) {
+	edges := make([]Edge[T], len(nodes))
+	if len(nodes) == 0 {
+		return edges, nil
+	}
+
+	nodeSchema, err := parseSchema[T](db)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*schema.Field, len(cursors))
+	for i, cursor := range cursors {
+		column := strings.TrimPrefix(cursor.Column(), nodeSchema.Table+".")
+		if fields[i] = nodeSchema.LookUpField(column); fields[i] == nil {
+			return nil, fmt.Errorf("%w: %q", ErrCursorColumnNotFound, cursor.Column())
+		}
+	}
+
+	withValues := make([]cursorpagination.Cursor, len(cursors))
+	for i, node := range nodes {
+		nodeValue := reflect.Indirect(reflect.ValueOf(node))
+		for j, cursor := range cursors {
+			value, _ := fields[j].ValueOf(ctx, nodeValue)
+			withValues[j] = cursor.WithValue(value)
+		}
+
+		edgePagination, errCursor := cursorpagination.New(0, withValues...)
+		if errCursor != nil {
+			return nil, errCursor
+		}
+
+		token, errCursor := edgePagination.Token()
+		if errCursor != nil {
+			return nil, errCursor
+		}
+
+		edges[i] = Edge[T]{Node: node, Cursor: token}
+	}
+
+	return edges, nil
+}
+
+func parseSchema[T any](db *gorm.DB) (*schema.Schema, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return nil, fmt.Errorf("parsing node schema: %w", err)
+	}
+
+	return stmt.Schema, nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
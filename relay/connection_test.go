@@ -0,0 +1,218 @@
+package relay
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/manuelarte/pagorminator"
+	"github.com/manuelarte/pagorminator/cursorpagination"
+)
+
+type (
+	testProduct struct {
+		ID    uint `gorm:"primarykey"`
+		Code  string
+		Price uint
+	}
+
+	testProductCode struct {
+		ID   uint `gorm:"primarykey"`
+		Code string
+	}
+)
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+	cursorOf := func(id uint) string {
+		token, err := cursorpagination.Must(0, cursorpagination.Asc("id", id)).Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	tests := map[string]struct {
+		args            Args
+		wantIDs         []uint
+		wantHasNext     bool
+		wantHasPrevious bool
+	}{
+		"first": {
+			args:        Args{First: ptr(2)},
+			wantIDs:     []uint{1, 2},
+			wantHasNext: true,
+		},
+		"first after": {
+			args:            Args{First: ptr(2), After: ptr(cursorOf(2))},
+			wantIDs:         []uint{3, 4},
+			wantHasNext:     true,
+			wantHasPrevious: true,
+		},
+		"first after, last page": {
+			args:            Args{First: ptr(2), After: ptr(cursorOf(3))},
+			wantIDs:         []uint{4, 5},
+			wantHasNext:     false,
+			wantHasPrevious: true,
+		},
+		"last": {
+			args:            Args{Last: ptr(2)},
+			wantIDs:         []uint{4, 5},
+			wantHasPrevious: true,
+		},
+		"last before": {
+			args:            Args{Last: ptr(2), Before: ptr(cursorOf(4))},
+			wantIDs:         []uint{2, 3},
+			wantHasNext:     true,
+			wantHasPrevious: true,
+		},
+		"last before, first page": {
+			args:            Args{Last: ptr(2), Before: ptr(cursorOf(3))},
+			wantIDs:         []uint{1, 2},
+			wantHasNext:     true,
+			wantHasPrevious: false,
+		},
+		"first zero": {
+			args:        Args{First: ptr(0)},
+			wantIDs:     []uint{},
+			wantHasNext: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Find[*testProduct](t.Context(), db, test.args, cursorpagination.Asc("id", nil))
+			if err != nil {
+				t.Fatalf("Find() unexpected error: %v", err)
+			}
+
+			gotIDs := make([]uint, len(got.Edges))
+			for i, edge := range got.Edges {
+				gotIDs[i] = edge.Node.ID
+				if want := cursorOf(edge.Node.ID); edge.Cursor != want {
+					t.Errorf("Find() edge %d cursor = %q, want %q", i, edge.Cursor, want)
+				}
+			}
+
+			if diff := cmp.Diff(test.wantIDs, gotIDs); diff != "" {
+				t.Errorf("Find() ids diff (-want +got):\n%s", diff)
+			}
+
+			wantPageInfo := PageInfo{
+				HasNextPage:     test.wantHasNext,
+				HasPreviousPage: test.wantHasPrevious,
+				StartCursor:     nil,
+				EndCursor:       nil,
+			}
+			if len(test.wantIDs) > 0 {
+				wantPageInfo.StartCursor = ptr(cursorOf(test.wantIDs[0]))
+				wantPageInfo.EndCursor = ptr(cursorOf(test.wantIDs[len(test.wantIDs)-1]))
+			}
+
+			if diff := cmp.Diff(wantPageInfo, got.PageInfo); diff != "" {
+				t.Errorf("Find() page info diff (-want +got):\n%s", diff)
+			}
+
+			if got.TotalCount != 5 {
+				t.Errorf("Find() total count = %d, want 5", got.TotalCount)
+			}
+		})
+	}
+}
+
+func TestFindErrors(t *testing.T) {
+	t.Parallel()
+
+	db := setupDB(t)
+
+	tests := map[string]struct {
+		args    Args
+		cursors []cursorpagination.Cursor
+		wantErr error
+	}{
+		"no first nor last": {
+			args:    Args{},
+			cursors: []cursorpagination.Cursor{cursorpagination.Asc("id", nil)},
+			wantErr: ErrFirstOrLastRequired,
+		},
+		"first and last": {
+			args:    Args{First: ptr(1), Last: ptr(1)},
+			cursors: []cursorpagination.Cursor{cursorpagination.Asc("id", nil)},
+			wantErr: ErrFirstAndLast,
+		},
+		"negative first": {
+			args:    Args{First: ptr(-1)},
+			cursors: []cursorpagination.Cursor{cursorpagination.Asc("id", nil)},
+			wantErr: ErrCountCantBeNegative,
+		},
+		"first before": {
+			args:    Args{First: ptr(1), Before: ptr("")},
+			cursors: []cursorpagination.Cursor{cursorpagination.Asc("id", nil)},
+			wantErr: ErrCursorDirectionNotValid,
+		},
+		"last with random order": {
+			args:    Args{Last: ptr(1)},
+			cursors: []cursorpagination.Cursor{cursorpagination.Random("id", 1, nil)},
+			wantErr: ErrOrderNotReversible,
+		},
+		"cursor column not in the node": {
+			args:    Args{First: ptr(1)},
+			cursors: []cursorpagination.Cursor{cursorpagination.Asc("price", nil)},
+			wantErr: ErrCursorColumnNotFound,
+		},
+		"cursor not valid": {
+			args:    Args{First: ptr(1), After: ptr("not a cursor")},
+			cursors: []cursorpagination.Cursor{cursorpagination.Asc("id", nil)},
+			wantErr: cursorpagination.ErrTokenNotValid,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Find[testProductCode](t.Context(), db.Table("test_products"), test.args, test.cursors...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Find() error = %v, want %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func setupDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
+	if err != nil {
+		t.Fatal("failed to connect database")
+	}
+
+	if err = db.AutoMigrate(&testProduct{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Use(pagorminator.PaGorminator{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range 5 {
+		if err = db.Create(&testProduct{Code: fmt.Sprintf("P%d", i+1), Price: uint(i + 1)}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return db
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,4 @@
+// Package relay implements the GraphQL Cursor Connections specification, https://relay.dev/graphql/connections.htm,
+// mapping the `first`, `after`, `last` and `before` arguments onto cursor pagination.
+// The connection types are plain Go structs, so they can be used with any GraphQL library.
+package relay
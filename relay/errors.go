@@ -0,0 +1,18 @@
+package relay
+
+import "errors"
+
+var (
+	// ErrFirstOrLastRequired is returned when neither first nor last are set.
+	ErrFirstOrLastRequired = errors.New("first or last is required")
+	// ErrFirstAndLast is returned when both first and last are set.
+	ErrFirstAndLast = errors.New("first and last can't be set at the same time")
+	// ErrCountCantBeNegative is returned when first or last are negative.
+	ErrCountCantBeNegative = errors.New("first and last can't be negative")
+	// ErrCursorDirectionNotValid is returned when before is set with first, or after is set with last.
+	ErrCursorDirectionNotValid = errors.New("before can't be set with first, nor after with last")
+	// ErrOrderNotReversible is returned when paginating backwards with a cursor order that can't be reversed.
+	ErrOrderNotReversible = errors.New("cursor order can't be reversed")
+	// ErrCursorColumnNotFound is returned when a cursor column is not a field of the node.
+	ErrCursorColumnNotFound = errors.New("cursor column not found in the node")
+)
@@ -0,0 +1,33 @@
+package pagorminator
+
+import (
+	"gorm.io/gorm"
+)
+
+// countOnReplica runs the count on the count replica, see CountDB and CountClauses,
+// failing over to the primary, tx, if the replica count fails.
+func (p PaGorminator) countOnReplica(db, tx *gorm.DB, count func(tx *gorm.DB) error) error {
+	if p.CountDB == nil && len(p.CountClauses) == 0 {
+		return count(tx)
+	}
+
+	// the sessions clone the statement, so each count runs on its own statement.
+	replicaTx := tx.Session(&gorm.Session{Context: db.Statement.Context})
+	if p.CountDB != nil {
+		replicaTx.Statement.ConnPool = p.CountDB.Statement.ConnPool
+	}
+
+	err := count(replicaTx.Clauses(p.CountClauses...))
+	if err == nil {
+		return nil
+	}
+
+	if p.Logger != nil {
+		p.Logger.WarnContext(db.Statement.Context, "pagorminator: count failed over to the primary",
+			"table", db.Statement.Table, "error", err)
+	} else {
+		db.Logger.Warn(db.Statement.Context, "pagorminator: count failed over to the primary: %v", err)
+	}
+
+	return count(tx.Session(&gorm.Session{Context: db.Statement.Context}))
+}
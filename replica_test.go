@@ -0,0 +1,206 @@
+package pagorminator
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/manuelarte/pagorminator/pagepagination"
+)
+
+// connPoolClause routes the statement to the connection pool, as dbresolver does.
+type connPoolClause struct {
+	connPool gorm.ConnPool
+}
+
+func (c connPoolClause) ModifyStatement(stmt *gorm.Statement) {
+	stmt.ConnPool = c.connPool
+}
+
+func (c connPoolClause) Build(_ clause.Builder) {}
+
+func TestCountOnReplica(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		migrateReplica bool
+		plugin         func(replica *gorm.DB) PaGorminator
+		rawSQL         string
+		wantTotal      int64
+	}{
+		"count on primary": {
+			migrateReplica: true,
+			plugin: func(_ *gorm.DB) PaGorminator {
+				return PaGorminator{}
+			},
+			wantTotal: 5,
+		},
+		"count on replica db": {
+			migrateReplica: true,
+			plugin: func(replica *gorm.DB) PaGorminator {
+				return New(WithCountDB(replica))
+			},
+			wantTotal: 3,
+		},
+		"count on replica clauses": {
+			migrateReplica: true,
+			plugin: func(replica *gorm.DB) PaGorminator {
+				return New(WithCountClauses(connPoolClause{connPool: replica.Statement.ConnPool}))
+			},
+			wantTotal: 3,
+		},
+		"raw count on replica db": {
+			migrateReplica: true,
+			plugin: func(replica *gorm.DB) PaGorminator {
+				return New(WithCountDB(replica))
+			},
+			rawSQL:    "SELECT * FROM test_structs",
+			wantTotal: 3,
+		},
+		"replica fails over to primary": {
+			plugin: func(replica *gorm.DB) PaGorminator {
+				return New(WithCountDB(replica))
+			},
+			wantTotal: 5,
+		},
+		"raw replica fails over to primary": {
+			plugin: func(replica *gorm.DB) PaGorminator {
+				return New(WithCountDB(replica))
+			},
+			rawSQL:    "SELECT * FROM test_structs",
+			wantTotal: 5,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			primary := openFileDB(t, filepath.Join(dir, "primary.db"), true)
+			migrateTestStructs(t, primary, 5)
+
+			// the replica lags behind the primary.
+			replica := openFileDB(t, filepath.Join(dir, "replica.db"), test.migrateReplica)
+			if test.migrateReplica {
+				migrateTestStructs(t, replica, 3)
+			}
+
+			if err := primary.Use(test.plugin(replica)); err != nil {
+				t.Fatal(err)
+			}
+
+			pageRequest := pagepagination.Must(0, 2)
+
+			tx := primary.Clauses(pageRequest)
+			if test.rawSQL != "" {
+				tx = tx.Raw(test.rawSQL)
+			}
+
+			var products []*TestStruct
+			if err := tx.Find(&products).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			if len(products) != 2 {
+				t.Errorf("expected 2 products from the primary, got %d", len(products))
+			}
+
+			if total, _ := pageRequest.TotalElements(); total != test.wantTotal {
+				t.Errorf("expected %d total elements, got %d", test.wantTotal, total)
+			}
+		})
+	}
+}
+
+func TestCountOnReplicaObservedSQL(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	primary := openFileDB(t, filepath.Join(dir, "primary.db"), true)
+	migrateTestStructs(t, primary, 5)
+
+	replica := openFileDB(t, filepath.Join(dir, "replica.db"), true)
+	migrateTestStructs(t, replica, 3)
+
+	var finished []CountFinishedEvent
+
+	observer := ObserverFunc(func(_ context.Context, event Event) {
+		if typed, ok := event.(CountFinishedEvent); ok {
+			finished = append(finished, typed)
+		}
+	})
+	if err := primary.Use(New(WithCountDB(replica), WithObserver(observer))); err != nil {
+		t.Fatal(err)
+	}
+
+	var products []*TestStruct
+	if err := primary.Clauses(pagepagination.Must(0, 2)).Find(&products).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if len(finished) != 1 {
+		t.Fatalf("count finished events = %+v, want one", finished)
+	}
+
+	if finished[0].TotalElements != 3 || !strings.Contains(finished[0].SQL, "count(*)") {
+		t.Errorf("count finished event = %+v, want the replica count query", finished[0])
+	}
+}
+
+func TestCountDBNotValid(t *testing.T) {
+	t.Parallel()
+
+	primary := openFileDB(t, filepath.Join(t.TempDir(), "primary.db"), false)
+
+	countDB, err := gorm.Open(dialectorName{Dialector: sqlite.Open(":memory:"), name: "postgres"}, &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = primary.Use(New(WithCountDB(countDB)))
+	if diff := cmp.Diff(ErrCountDBNotValid, err, cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("error mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// dialectorName overrides the dialector name.
+type dialectorName struct {
+	gorm.Dialector
+
+	name string
+}
+
+func (d dialectorName) Name() string {
+	return d.name
+}
+
+func openFileDB(t *testing.T, path string, migrate bool) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if migrate {
+		if err = db.AutoMigrate(&TestStruct{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Cleanup(func() {
+		if sqlDB, errDB := db.DB(); errDB == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	return db
+}